@@ -0,0 +1,43 @@
+package errs
+
+// Annotate function wraps *errp with the caller ("function" context) and options if *errp is not nil.
+// This function must be called directly by defer statement:
+//
+//	func load(id string) (err error) {
+//		defer errs.Annotate(&err, errs.WithContext("id", id))
+//		...
+//	}
+//
+// If *errp is *Error instance created in the same function (e.g. by New or Wrap function),
+// the options are applied to it instead of wrapping (context values of the same key are overwritten).
+func Annotate(errp *error, opts ...ErrorContextFunc) {
+	if errp == nil || *errp == nil {
+		return
+	}
+	if e, ok := (*errp).(*Error); ok && e != nil {
+		if fname, _, _ := caller(1); len(fname) > 0 {
+			if f, ok := e.Context["function"].(string); ok && f == fname {
+				for _, opt := range opts {
+					opt(e)
+				}
+				return
+			}
+		}
+	}
+	*errp = newError(*errp, true, 2, opts...)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,62 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func annotateFunc(id int, err error, local bool) (e error) {
+	defer Annotate(&e, WithContext("id", id))
+	if local {
+		return New("local error", WithCause(err), WithContext("local", true))
+	}
+	return err
+}
+
+func TestAnnotate(t *testing.T) {
+	testCases := []struct {
+		err   error
+		local bool
+		want  string
+	}{
+		{err: nil, local: false, want: "null"},
+		{err: io.EOF, local: false, want: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"EOF"},"Context":{"function":"github.com/goark/errs.annotateFunc","id":1}}`},
+		{err: io.EOF, local: true, want: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"local error"},"Context":{"function":"github.com/goark/errs.annotateFunc","id":1,"local":true},"Cause":{"Type":"*errors.errorString","Msg":"EOF"}}`},
+		{err: New("other function"), local: false, want: `{"Type":"*errs.Error","Err":{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"other function"},"Context":{"function":"github.com/goark/errs.TestAnnotate"}},"Context":{"function":"github.com/goark/errs.annotateFunc","id":1}}`},
+	}
+
+	for _, tc := range testCases {
+		err := annotateFunc(1, tc.err, tc.local)
+		if got := EncodeJSON(err); got != tc.want {
+			t.Errorf("annotateFunc(%v, %v) is %v, want %v", tc.err, tc.local, got, tc.want)
+		}
+		if tc.err != nil && !errors.Is(err, tc.err) {
+			t.Errorf("errors.Is(%v, %v) is false, want true", err, tc.err)
+		}
+	}
+}
+
+func TestAnnotateNil(t *testing.T) {
+	Annotate(nil)
+	var err error
+	Annotate(&err)
+	if err != nil {
+		t.Errorf("Annotate(nil error) is %v, want <nil>", err)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,267 @@
+package errs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Batch is a set of errors per item (index or key) of bulk operation.
+// Batch is safe for concurrent use, and zero value of Batch is usable.
+type Batch[K comparable] struct {
+	mu        sync.RWMutex
+	keys      []K // keys of failed items in recorded order
+	errs      map[K]error
+	succeeded int
+}
+
+var _ error = (*Batch[int])(nil)          //Batch type is compatible with error interface
+var _ json.Marshaler = (*Batch[int])(nil) //Batch type is compatible with json.Marshaler interface
+
+// NewBatch function returns empty Batch instance.
+func NewBatch[K comparable]() *Batch[K] {
+	return &Batch[K]{}
+}
+
+// Record method records the result of item. If err is nil, the item is counted as succeeded.
+// If the error of the same key has been already recorded, it is joined to err (see Join function).
+func (b *Batch[K]) Record(key K, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err == nil {
+		b.succeeded++
+		return
+	}
+	if b.errs == nil {
+		b.errs = map[K]error{}
+	}
+	if prev, ok := b.errs[key]; ok {
+		b.errs[key] = Join(prev, err)
+		return
+	}
+	b.keys = append(b.keys, key)
+	b.errs[key] = err
+}
+
+// Get method returns the error of item (nil if the item is succeeded or not recorded).
+func (b *Batch[K]) Get(key K) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.errs[key]
+}
+
+// Failed method returns keys of failed items in recorded order.
+func (b *Batch[K]) Failed() []K {
+	if b == nil {
+		return nil
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.keys) == 0 {
+		return nil
+	}
+	keys := make([]K, len(b.keys))
+	copy(keys, b.keys)
+	return keys
+}
+
+// Succeeded method returns the number of succeeded items.
+func (b *Batch[K]) Succeeded() int {
+	if b == nil {
+		return 0
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.succeeded
+}
+
+// ErrorOrNil method returns this as a error type if any item is failed, or nil otherwise.
+func (b *Batch[K]) ErrorOrNil() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.keys) == 0 {
+		return nil
+	}
+	return b
+}
+
+// Error method returns error message ("key: message" per failed item).
+// This method is a implementation of error interface.
+func (b *Batch[K]) Error() string {
+	if b == nil {
+		return nilAngleString
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.keys) == 0 {
+		return nilAngleString
+	}
+	var buf []byte
+	for i, k := range b.keys {
+		if i > 0 {
+			buf = append(buf, '\n')
+		}
+		buf = append(buf, fmt.Sprint(k)...)
+		buf = append(buf, ": "...)
+		buf = append(buf, b.errs[k].Error()...)
+	}
+	return string(buf)
+}
+
+// String method returns error message.
+// This method is a implementation of fmt.Stringer interface.
+func (b *Batch[K]) String() string {
+	return b.Error()
+}
+
+// GoString method returns serialize string of Batch.
+// This method is a implementation of fmt.GoStringer interface.
+func (b *Batch[K]) GoString() string {
+	if b == nil {
+		return nilAngleString
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return fmt.Sprintf("%T{Errs:%#v, Succeeded:%d}", b, b.errs, b.succeeded)
+}
+
+// MarshalJSON method returns serialize string of Batch with JSON format.
+// This method is implementation of json.Marshaler interface.
+func (b *Batch[K]) MarshalJSON() ([]byte, error) {
+	return []byte(b.EncodeJSON()), nil
+}
+
+// Format method returns formatted string of Batch instance.
+// This method is a implementation of fmt.Formatter interface.
+func (b *Batch[K]) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case s.Flag('#'):
+			_, _ = strings.NewReader(b.GoString()).WriteTo(s)
+		case s.Flag('+'):
+			_, _ = strings.NewReader(b.EncodeJSON()).WriteTo(s)
+		default:
+			_, _ = strings.NewReader(b.Error()).WriteTo(s)
+		}
+	case 's':
+		_, _ = strings.NewReader(b.String()).WriteTo(s)
+	default:
+		fmt.Fprintf(s, `%%!%c(%s)`, verb, b.GoString())
+	}
+}
+
+// EncodeJSON method returns serialize string of Batch with JSON format.
+// Error trees of failed items are output as "Items" object keyed by the item keys,
+// and the number of succeeded items is output as "Succeeded" in "Fields".
+// DecodeJSON function restores it as *RemoteError with the error trees as causes.
+func (b *Batch[K]) EncodeJSON() string {
+	if b == nil {
+		return "null"
+	}
+	msg := b.Error()
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	elms := []string{}
+	elms = append(elms, strings.Join([]string{`"Type":`, strconv.Quote(reflect.TypeOf(b).String())}, ""))
+	msgBuf := &bytes.Buffer{}
+	json.HTMLEscape(msgBuf, bytes.Join([][]byte{[]byte(`"Msg":`), []byte(strconv.Quote(msg))}, []byte{}))
+	elms = append(elms, msgBuf.String())
+	elms = append(elms, strings.Join([]string{`"Fields":{"Succeeded":`, strconv.Itoa(b.succeeded), "}"}, ""))
+	if len(b.keys) > 0 {
+		items := make([]string, 0, len(b.keys))
+		for _, k := range b.keys {
+			itemBuf := &bytes.Buffer{}
+			json.HTMLEscape(itemBuf, []byte(strings.Join([]string{strconv.Quote(fmt.Sprint(k)), ":", EncodeJSON(b.errs[k])}, "")))
+			items = append(items, itemBuf.String())
+		}
+		elms = append(elms, strings.Join([]string{`"Items":{`, strings.Join(items, ","), "}"}, ""))
+	}
+	return strings.Join([]string{"{", strings.Join(elms, ","), "}"}, "")
+}
+
+// Unwrap method returns errors of failed items in recorded order.
+// This method is used in errors.Is and errors.As functions.
+func (b *Batch[K]) Unwrap() []error {
+	if b == nil {
+		return nil
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.keys) == 0 {
+		return nil
+	}
+	errlist := make([]error, 0, len(b.keys))
+	for _, k := range b.keys {
+		errlist = append(errlist, b.errs[k])
+	}
+	return errlist
+}
+
+// ForEach function calls fn for each item with at most concurrency goroutines, and records the results in Batch
+// keyed by index of item (in index order). If concurrency is less than 1, items are processed sequentially.
+// If ctx is done, remaining items are not processed and recorded as failed with ctx.Err().
+func ForEach[T any](ctx context.Context, items []T, concurrency int, fn func(ctx context.Context, i int, item T) error) *Batch[int] {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	results := make([]error, len(items))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		select {
+		case <-ctx.Done():
+			results[i] = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+		if err := ctx.Err(); err != nil {
+			<-sem
+			results[i] = err
+			continue
+		}
+		wg.Add(1)
+		go func(i int, item T) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i] = fn(ctx, i, item)
+		}(i, item)
+	}
+	wg.Wait()
+	b := NewBatch[int]()
+	for i, err := range results {
+		b.Record(i, err)
+	}
+	return b
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,126 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	b := NewBatch[string]()
+	if err := b.ErrorOrNil(); err != nil {
+		t.Errorf("Batch.ErrorOrNil() is %v, want <nil>", err)
+	}
+	b.Record("a", nil)
+	b.Record("b", io.EOF)
+	b.Record("c", nil)
+	b.Record("d", &fs1Error{})
+	b.Record("b", os.ErrNotExist)
+
+	if got, want := strings.Join(b.Failed(), ","), "b,d"; got != want {
+		t.Errorf("Batch.Failed() is %v, want %v", got, want)
+	}
+	if got := b.Succeeded(); got != 2 {
+		t.Errorf("Batch.Succeeded() is %v, want %v", got, 2)
+	}
+	if got := b.Get("a"); got != nil {
+		t.Errorf("Batch.Get(\"a\") is %v, want <nil>", got)
+	}
+	err := b.ErrorOrNil()
+	for _, target := range []error{io.EOF, os.ErrNotExist} {
+		if !errors.Is(err, target) {
+			t.Errorf("errors.Is(%v, %v) is false, want true", err, target)
+		}
+	}
+	var fe *fs1Error
+	if !errors.As(err, &fe) {
+		t.Errorf("errors.As(%v, *fs1Error) is false, want true", err)
+	}
+	if got, want := err.Error(), "b: EOF\nfile does not exist\nd: fs1 error"; got != want {
+		t.Errorf("Batch.Error() is %q, want %q", got, want)
+	}
+	json := EncodeJSON(err)
+	want := `{"Type":"*errs.Batch[string]","Msg":"b: EOF\nfile does not exist\nd: fs1 error","Fields":{"Succeeded":2},"Items":{"b":{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"EOF"},{"Type":"*errors.errorString","Msg":"file does not exist"}]},"d":{"Type":"*errs.fs1Error","Msg":"fs1 error"}}}`
+	if json != want {
+		t.Errorf("EncodeJSON(Batch) is %v, want %v", json, want)
+	}
+	res, e := DecodeJSON([]byte(json))
+	if e != nil {
+		t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", json, e)
+		return
+	}
+	if len(Unwraps(res)) != 2 || res.Error() != err.Error() {
+		t.Errorf("DecodeJSON(%v) is %+v, want *errs.RemoteError with failed items as causes", json, res)
+	}
+}
+
+type fs1Error struct{}
+
+func (e *fs1Error) Error() string { return "fs1 error" }
+
+func TestForEach(t *testing.T) {
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+	var running, peak int32
+	b := ForEach(context.Background(), items, 3, func(ctx context.Context, i int, item int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		if item%7 == 3 {
+			return errors.New("item " + strconv.Itoa(item))
+		}
+		return nil
+	})
+	if peak > 3 {
+		t.Errorf("concurrency of ForEach() is %v, want <= %v", peak, 3)
+	}
+	if got := b.Failed(); len(got) != 3 || got[0] != 3 || got[1] != 10 || got[2] != 17 {
+		t.Errorf("Batch.Failed() is %v, want %v", got, []int{3, 10, 17})
+	}
+	if got := b.Succeeded(); got != 17 {
+		t.Errorf("Batch.Succeeded() is %v, want %v", got, 17)
+	}
+}
+
+func TestForEachCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := ForEach(ctx, []string{"a", "b", "c", "d"}, 0, func(ctx context.Context, i int, item string) error {
+		if item == "b" {
+			cancel()
+		}
+		return nil
+	})
+	if got := b.Failed(); len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("Batch.Failed() is %v, want %v", got, []int{2, 3})
+	}
+	if err := b.ErrorOrNil(); !errors.Is(err, context.Canceled) {
+		t.Errorf("errors.Is(%v, context.Canceled) is false, want true", err)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
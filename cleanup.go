@@ -0,0 +1,65 @@
+package errs
+
+import (
+	"fmt"
+	"io"
+)
+
+// Close function closes c and sets the close error to *errp.
+// This function is used in defer statement instead of c.Close method:
+//
+//	func checkFileOpen(path string) (err error) {
+//		file, err := os.Open(path)
+//		if err != nil {
+//			return errs.Wrap(err)
+//		}
+//		defer errs.Close(&err, file)
+//		...
+//	}
+//
+// The close error is wrapped with the caller ("function" context) and type of c ("closer" context).
+// If *errp is nil, *errp is set to the close error.
+// Otherwise *errp and the close error are joined into *Errors instance (see Join function).
+func Close(errp *error, c io.Closer) {
+	if c == nil {
+		return
+	}
+	cleanup(errp, c.Close, WithContext("closer", fmt.Sprintf("%T", c)))
+}
+
+// Cleanup function calls fn and sets the error to *errp in the same manner as Close function.
+// The error is wrapped with the caller ("function" context).
+func Cleanup(errp *error, fn func() error) {
+	if fn == nil {
+		return
+	}
+	cleanup(errp, fn)
+}
+
+func cleanup(errp *error, fn func() error, opts ...ErrorContextFunc) {
+	err := fn()
+	if err == nil || errp == nil {
+		return
+	}
+	err = newError(err, true, 3, opts...)
+	if *errp == nil {
+		*errp = err
+		return
+	}
+	*errp = Join(*errp, err)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,93 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+type testCloser struct {
+	err error
+}
+
+func (c *testCloser) Close() error { return c.err }
+
+func closeFunc(mainErr, closeErr error) (err error) {
+	defer Close(&err, &testCloser{err: closeErr})
+	return mainErr
+}
+
+func cleanupFunc(mainErr, cleanupErr error) (err error) {
+	defer Cleanup(&err, func() error { return cleanupErr })
+	return mainErr
+}
+
+func TestClose(t *testing.T) {
+	testCases := []struct {
+		mainErr  error
+		closeErr error
+		want     string
+	}{
+		{mainErr: nil, closeErr: nil, want: "null"},
+		{mainErr: io.EOF, closeErr: nil, want: `{"Type":"*errors.errorString","Msg":"EOF"}`},
+		{mainErr: nil, closeErr: os.ErrClosed, want: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"file already closed"},"Context":{"closer":"*errs.testCloser","function":"github.com/goark/errs.closeFunc"}}`},
+		{mainErr: io.EOF, closeErr: os.ErrClosed, want: `{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"EOF"},{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"file already closed"},"Context":{"closer":"*errs.testCloser","function":"github.com/goark/errs.closeFunc"}}]}`},
+	}
+
+	for _, tc := range testCases {
+		err := closeFunc(tc.mainErr, tc.closeErr)
+		if got := EncodeJSON(err); got != tc.want {
+			t.Errorf("closeFunc(%v, %v) is %v, want %v", tc.mainErr, tc.closeErr, got, tc.want)
+		}
+		for _, target := range []error{tc.mainErr, tc.closeErr} {
+			if target != nil && !errors.Is(err, target) {
+				t.Errorf("errors.Is(%v, %v) is false, want true", err, target)
+			}
+		}
+	}
+}
+
+func TestCleanup(t *testing.T) {
+	testCases := []struct {
+		mainErr    error
+		cleanupErr error
+		want       string
+	}{
+		{mainErr: nil, cleanupErr: nil, want: "null"},
+		{mainErr: nil, cleanupErr: os.ErrClosed, want: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"file already closed"},"Context":{"function":"github.com/goark/errs.cleanupFunc"}}`},
+		{mainErr: io.EOF, cleanupErr: os.ErrClosed, want: `{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"EOF"},{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"file already closed"},"Context":{"function":"github.com/goark/errs.cleanupFunc"}}]}`},
+	}
+
+	for _, tc := range testCases {
+		err := cleanupFunc(tc.mainErr, tc.cleanupErr)
+		if got := EncodeJSON(err); got != tc.want {
+			t.Errorf("cleanupFunc(%v, %v) is %v, want %v", tc.mainErr, tc.cleanupErr, got, tc.want)
+		}
+	}
+}
+
+func TestCloseNil(t *testing.T) {
+	var err error
+	Close(&err, nil)
+	Cleanup(&err, nil)
+	Close(nil, &testCloser{err: io.EOF})
+	if err != nil {
+		t.Errorf("Close(nil) is %v, want <nil>", err)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
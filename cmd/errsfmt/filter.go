@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/goark/errs"
+)
+
+// ctxFilter is a filter by context value.
+type ctxFilter struct {
+	key      string
+	value    string
+	hasValue bool
+}
+
+// filter is a set of conditions for errors (all conditions must be satisfied).
+type filter struct {
+	typ  string
+	ctxs []ctxFilter
+	msg  *regexp.Regexp
+}
+
+// match reports whether error tree satisfies the filter.
+func (f *filter) match(err error) bool {
+	typeOK := len(f.typ) == 0
+	msgOK := f.msg == nil
+	ctxOK := make([]bool, len(f.ctxs))
+	errs.Walk(err, func(e error, _ int) bool {
+		if !typeOK && errs.TypeName(e) == f.typ {
+			typeOK = true
+		}
+		if !msgOK && f.msg.MatchString(e.Error()) {
+			msgOK = true
+		}
+		ee, ok := e.(*errs.Error)
+		if !ok {
+			return true
+		}
+		for i, c := range f.ctxs {
+			v, ok := ee.Context[c.key]
+			if ok && (!c.hasValue || fmt.Sprint(v) == c.value) {
+				ctxOK[i] = true
+			}
+		}
+		return true
+	})
+	for _, ok := range ctxOK {
+		if !ok {
+			return false
+		}
+	}
+	return typeOK && msgOK
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,149 @@
+// Command errsfmt pretty-prints, filters and summarizes JSON data of errs package in log files.
+//
+// errsfmt reads JSON lines from files (or standard input), finds JSON objects
+// encoded by errs.EncodeJSON function (including embedded ones in larger log records),
+// and renders them as trees.
+//
+//	Usage:
+//	  errsfmt [flags] [file ...]
+//
+//	Flags:
+//	  -type string    print errors that contain the error type (e.g. "*fs.PathError")
+//	  -ctx key=value  print errors that have the context value (repeatable; "key" only matches existence)
+//	  -msg regexp     print errors that contain a message matched by the regular expression
+//	  -summary        print counts by type, root cause and "function" context instead of trees
+//	  -top int        number of rows in each summary table (default 10)
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+// ctxFlags is a repeatable flag for context filters.
+type ctxFlags []string
+
+func (c *ctxFlags) String() string     { return strings.Join(*c, ",") }
+func (c *ctxFlags) Set(s string) error { *c = append(*c, s); return nil }
+
+// record is an error found in log files.
+type record struct {
+	source string
+	err    error
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("errsfmt", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var (
+		ctxs ctxFlags
+		f    filter
+	)
+	typ := fs.String("type", "", "print errors that contain the error type")
+	fs.Var(&ctxs, "ctx", "print errors that have the context value (key=value, repeatable)")
+	msg := fs.String("msg", "", "print errors that contain a message matched by the regular expression")
+	summary := fs.Bool("summary", false, "print counts by type, root cause and \"function\" context")
+	top := fs.Int("top", 10, "number of rows in each summary table")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	f.typ = *typ
+	for _, c := range ctxs {
+		k, v, ok := strings.Cut(c, "=")
+		f.ctxs = append(f.ctxs, ctxFilter{key: k, value: v, hasValue: ok})
+	}
+	if len(*msg) > 0 {
+		re, err := regexp.Compile(*msg)
+		if err != nil {
+			fmt.Fprintln(stderr, errs.Wrap(err, errs.WithContext("msg", *msg)))
+			return 2
+		}
+		f.msg = re
+	}
+
+	var records []record
+	read := func(name string, r io.Reader) error {
+		return scanLines(name, r, func(rec record) {
+			if !f.match(rec.err) {
+				return
+			}
+			if *summary {
+				records = append(records, rec)
+				return
+			}
+			fmt.Fprintf(stdout, "%s\n", rec.source)
+			render(stdout, rec.err)
+			fmt.Fprintln(stdout)
+		})
+	}
+	status := 0
+	if fs.NArg() == 0 {
+		if err := read("-", stdin); err != nil {
+			fmt.Fprintln(stderr, err)
+			status = 1
+		}
+	}
+	for _, name := range fs.Args() {
+		if err := readFile(name, stdin, read); err != nil {
+			fmt.Fprintln(stderr, err)
+			status = 1
+		}
+	}
+	if *summary {
+		summarize(stdout, records, *top)
+	}
+	return status
+}
+
+func readFile(name string, stdin io.Reader, read func(string, io.Reader) error) error {
+	if name == "-" {
+		return read(name, stdin)
+	}
+	file, err := os.Open(name)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("file", name))
+	}
+	defer file.Close()
+	return read(name, file)
+}
+
+// scanLines reads lines and calls fn for each error found.
+func scanLines(name string, r io.Reader, fn func(record)) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for n := 1; sc.Scan(); n++ {
+		for _, err := range extract(sc.Bytes()) {
+			fn(record{source: fmt.Sprintf("%s:%d", name, n), err: err})
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return errs.Wrap(err, errs.WithContext("file", name))
+	}
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const testLog = `{"level":"error","msg":"failed","error":{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"file open error"},"Context":{"function":"main.checkFileOpen","path":"not-exist.txt"},"Cause":{"Type":"*fs.PathError","Msg":"open not-exist.txt: no such file or directory","Fields":{"Op":"open","Path":"not-exist.txt"},"Cause":{"Type":"syscall.Errno","Msg":"no such file or directory","Fields":{"Errno":2,"Name":"ENOENT"}}}}}
+2026-01-01T00:00:00Z ERROR {"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"error 1"},{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"EOF"},"Context":{"function":"main.read"}}]}
+{"level":"info","msg":"no error here"}
+{"msg":"embedded","err":"{\"Type\":\"*errors.joinError\",\"Msg\":\"EOF\\nunexpected EOF\",\"Cause\":[{\"Type\":\"*errors.errorString\",\"Msg\":\"EOF\"},{\"Type\":\"*errors.errorString\",\"Msg\":\"unexpected EOF\"}]}"}
+`
+
+func TestRun(t *testing.T) {
+	testCases := []struct {
+		args []string
+		want string
+	}{
+		{
+			args: []string{"-ctx", "path=not-exist.txt"},
+			want: `-:1
+*errs.Error: file open error: open not-exist.txt: no such file or directory
+├─ context: function=main.checkFileOpen path=not-exist.txt
+├─ err: *errors.errorString: file open error
+└─ cause: *fs.PathError: open not-exist.txt: no such file or directory
+   └─ cause: syscall.Errno: no such file or directory
+
+`,
+		},
+		{
+			args: []string{"-type", "*errs.Errors"},
+			want: `-:2
+*errs.Errors (2 errors)
+├─ [0]: *errors.errorString: error 1
+└─ [1]: *errs.Error: EOF
+   ├─ context: function=main.read
+   └─ err: *errors.errorString: EOF
+
+`,
+		},
+		{
+			args: []string{"-msg", "^unexpected"},
+			want: `-:4
+*errors.joinError: EOF\nunexpected EOF
+├─ cause[0]: *errors.errorString: EOF
+└─ cause[1]: *errors.errorString: unexpected EOF
+
+`,
+		},
+		{
+			args: []string{"-ctx", "function", "-msg", "no such", "-type", "syscall.Errno"},
+			want: `-:1
+*errs.Error: file open error: open not-exist.txt: no such file or directory
+├─ context: function=main.checkFileOpen path=not-exist.txt
+├─ err: *errors.errorString: file open error
+└─ cause: *fs.PathError: open not-exist.txt: no such file or directory
+   └─ cause: syscall.Errno: no such file or directory
+
+`,
+		},
+		{
+			args: []string{"-summary", "-top", "2"},
+			want: `records: 3
+
+by type:
+       3  *errors.errorString
+       2  *errs.Error
+
+by root cause:
+       2  *errors.errorString: EOF
+       1  *errors.errorString: error 1
+
+by function:
+       1  main.checkFileOpen
+       1  main.read
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		if status := run(tc.args, strings.NewReader(testLog), stdout, stderr); status != 0 {
+			t.Errorf("run(%v) is %v, want 0 (%v)", tc.args, status, stderr)
+		}
+		if got := stdout.String(); got != tc.want {
+			t.Errorf("run(%v) output is\n%v\nwant\n%v", tc.args, got, tc.want)
+		}
+	}
+}
+
+func TestRunError(t *testing.T) {
+	testCases := []struct {
+		args   []string
+		status int
+	}{
+		{args: []string{"-msg", "("}, status: 2},
+		{args: []string{"-unknown"}, status: 2},
+		{args: []string{"not-exist.log"}, status: 1},
+	}
+
+	for _, tc := range testCases {
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		if status := run(tc.args, strings.NewReader(""), stdout, stderr); status != tc.status {
+			t.Errorf("run(%v) is %v, want %v", tc.args, status, tc.status)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
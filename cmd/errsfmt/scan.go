@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+// extract returns errors found in a line of log file.
+// JSON values in the line are decoded once, and JSON objects of errs package are searched in the parsed values
+// and in JSON string values (escaped JSON embedded in log records).
+func extract(line []byte) []error {
+	var found []error
+	for i := 0; i < len(line); {
+		j := bytes.IndexAny(line[i:], "{[")
+		if j < 0 {
+			break
+		}
+		i += j
+		dec := json.NewDecoder(bytes.NewReader(line[i:]))
+		dec.UseNumber()
+		var v interface{}
+		if dec.Decode(&v) != nil {
+			i++
+			continue
+		}
+		found = append(found, walkValue(v)...)
+		i += int(dec.InputOffset())
+	}
+	if len(found) > 0 || !json.Valid(line) {
+		return found
+	}
+	var s string
+	if json.Unmarshal(line, &s) == nil && strings.Contains(s, `"Type"`) {
+		return extract([]byte(s))
+	}
+	return found
+}
+
+// walkValue returns errors found in parsed JSON value (outermost error objects only).
+func walkValue(v interface{}) []error {
+	var found []error
+	switch x := v.(type) {
+	case map[string]interface{}:
+		if isErrorObject(x) {
+			if b, err := json.Marshal(x); err == nil {
+				if err, e := errs.DecodeJSON(b); e == nil && err != nil {
+					return []error{err}
+				}
+			}
+		}
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			found = append(found, walkValue(x[k])...)
+		}
+	case []interface{}:
+		for _, e := range x {
+			found = append(found, walkValue(e)...)
+		}
+	case string:
+		if strings.Contains(x, `"Type"`) {
+			found = append(found, extract([]byte(x))...)
+		}
+	}
+	return found
+}
+
+// isErrorObject reports whether JSON object is encoded by errs.EncodeJSON function.
+func isErrorObject(obj map[string]interface{}) bool {
+	if typ, ok := obj["Type"].(string); !ok || len(typ) == 0 {
+		return false
+	}
+	for _, k := range []string{"Err", "Msg", "Errs"} {
+		if _, ok := obj[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	errJSON := `{"Type":"*errors.errorString","Msg":"EOF"}`
+	testCases := []struct {
+		line string
+		msgs []string
+	}{
+		{line: `no json`, msgs: nil},
+		{line: `{"a":{"b":[{"c":1}]},"error":` + errJSON + `}`, msgs: []string{"EOF"}},
+		{line: `[INFO] {"a":{"b":1}} ` + errJSON + ` {"c":` + errJSON + `}`, msgs: []string{"EOF", "EOF"}},
+		{line: `{"err":"{\"Type\":\"*errors.errorString\",\"Msg\":\"embedded\"}"}`, msgs: []string{"embedded"}},
+		{line: `{"Type":"*errs.Errors","Errs":[` + errJSON + `]}`, msgs: []string{"EOF"}},
+		{line: `{"broken":` + errJSON, msgs: []string{"EOF"}},
+	}
+
+	for _, tc := range testCases {
+		found := extract([]byte(tc.line))
+		if len(found) != len(tc.msgs) {
+			t.Errorf("extract(%v) is %v, want %v", tc.line, found, tc.msgs)
+			continue
+		}
+		for i, err := range found {
+			if err.Error() != tc.msgs[i] {
+				t.Errorf("extract(%v)[%d] is %v, want %v", tc.line, i, err, tc.msgs[i])
+			}
+		}
+	}
+}
+
+func TestExtractLargeLine(t *testing.T) {
+	objs := make([]string, 50000)
+	for i := range objs {
+		objs[i] = `{"a":{"b":1}}`
+	}
+	line := `{"items":[` + strings.Join(objs, ",") + `],"error":{"Type":"*errors.errorString","Msg":"EOF"}}`
+	if found := extract([]byte(line)); len(found) != 1 {
+		t.Errorf("extract(large line) is %v, want 1 error", found)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
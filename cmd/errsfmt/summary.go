@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/goark/errs"
+)
+
+// counter counts occurrences of keys.
+type counter map[string]int
+
+// addOnce counts each key once.
+func (c counter) addOnce(keys map[string]bool) {
+	for k := range keys {
+		c[k]++
+	}
+}
+
+// summarize writes counts by type, root cause and "function" context.
+func summarize(w io.Writer, records []record, top int) {
+	byType, byRoot, byFunc := counter{}, counter{}, counter{}
+	for _, rec := range records {
+		types, roots, funcs := map[string]bool{}, map[string]bool{}, map[string]bool{}
+		errs.Walk(rec.err, func(e error, _ int) bool {
+			types[errs.TypeName(e)] = true
+			if ee, ok := e.(*errs.Error); ok {
+				if fn, ok := ee.Context["function"]; ok {
+					funcs[fmt.Sprint(fn)] = true
+				}
+			}
+			return true
+		})
+		for _, root := range rootCauses(rec.err) {
+			roots[fmt.Sprintf("%s: %s", errs.TypeName(root), root.Error())] = true
+		}
+		byType.addOnce(types)
+		byRoot.addOnce(roots)
+		byFunc.addOnce(funcs)
+	}
+	fmt.Fprintf(w, "records: %d\n", len(records))
+	for _, t := range []struct {
+		title string
+		c     counter
+	}{
+		{title: "by type", c: byType},
+		{title: "by root cause", c: byRoot},
+		{title: "by function", c: byFunc},
+	} {
+		fmt.Fprintf(w, "\n%s:\n", t.title)
+		for _, k := range t.c.ranking(top) {
+			fmt.Fprintf(w, "%8d  %s\n", t.c[k], k)
+		}
+	}
+}
+
+// ranking returns keys in descending order of counts.
+func (c counter) ranking(top int) []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if c[keys[i]] != c[keys[j]] {
+			return c[keys[i]] > c[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	if top > 0 && len(keys) > top {
+		keys = keys[:top]
+	}
+	return keys
+}
+
+// rootCauses returns leaf errors of cause chain.
+// *errs.Error instance follows Cause field if exists (Err field otherwise).
+func rootCauses(err error) []error {
+	var next []error
+	switch e := err.(type) {
+	case *errs.Error:
+		switch {
+		case e.Cause != nil:
+			next = []error{e.Cause}
+		case e.Err != nil:
+			next = []error{e.Err}
+		}
+	default:
+		next = errs.Unwraps(err)
+	}
+	if len(next) == 0 {
+		return []error{err}
+	}
+	var roots []error
+	for _, c := range next {
+		roots = append(roots, rootCauses(c)...)
+	}
+	return roots
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
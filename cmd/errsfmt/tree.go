@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+// node is a labeled child in error tree.
+type node struct {
+	label string
+	err   error
+}
+
+// children returns child errors of error instance.
+func children(err error) []node {
+	switch e := err.(type) {
+	case *errs.Error:
+		nodes := []node{}
+		if e.Err != nil {
+			nodes = append(nodes, node{label: "err", err: e.Err})
+		}
+		if e.Cause != nil {
+			nodes = append(nodes, node{label: "cause", err: e.Cause})
+		}
+		return nodes
+	case *errs.Errors:
+		list := e.Unwrap()
+		nodes := make([]node, 0, len(list))
+		for i, c := range list {
+			nodes = append(nodes, node{label: fmt.Sprintf("[%d]", i), err: c})
+		}
+		return nodes
+	}
+	list := errs.Unwraps(err)
+	if len(list) == 1 {
+		return []node{{label: "cause", err: list[0]}}
+	}
+	nodes := make([]node, 0, len(list))
+	for i, c := range list {
+		nodes = append(nodes, node{label: fmt.Sprintf("cause[%d]", i), err: c})
+	}
+	return nodes
+}
+
+// render writes error tree.
+func render(w io.Writer, err error) {
+	fmt.Fprintln(w, title(err))
+	renderChildren(w, err, "")
+}
+
+func renderChildren(w io.Writer, err error, indent string) {
+	nodes := children(err)
+	ctx := contextLine(err)
+	n := len(nodes)
+	if len(ctx) > 0 {
+		n++
+	}
+	i := 0
+	branch := func() (string, string) {
+		i++
+		if i == n {
+			return indent + "└─ ", indent + "   "
+		}
+		return indent + "├─ ", indent + "│  "
+	}
+	if len(ctx) > 0 {
+		head, _ := branch()
+		fmt.Fprintf(w, "%scontext: %s\n", head, ctx)
+	}
+	for _, c := range nodes {
+		head, next := branch()
+		fmt.Fprintf(w, "%s%s: %s\n", head, c.label, title(c.err))
+		renderChildren(w, c.err, next)
+	}
+}
+
+// title returns a line of error instance.
+func title(err error) string {
+	if es, ok := err.(*errs.Errors); ok {
+		return fmt.Sprintf("%s (%d errors)", errs.TypeName(es), len(es.Unwrap()))
+	}
+	msg := strings.ReplaceAll(err.Error(), "\n", `\n`)
+	return fmt.Sprintf("%s: %s", errs.TypeName(err), msg)
+}
+
+// contextLine returns context data of *errs.Error instance.
+func contextLine(err error) string {
+	e, ok := err.(*errs.Error)
+	if !ok || len(e.Context) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(e.Context))
+	for k := range e.Context {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	elms := make([]string, 0, len(keys))
+	for _, k := range keys {
+		elms = append(elms, fmt.Sprintf("%s=%v", k, e.Context[k]))
+	}
+	return strings.Join(elms, " ")
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,244 @@
+package errs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// collectorShards is number of shards in Collector.
+const collectorShards = 32
+
+// Collector is multiple error instance for high-contention use (e.g. thousands of goroutines reporting errors).
+// Add method appends errors to sharded lists, so goroutines rarely wait for each other.
+// The order of errors is the order of Add calls (errors added concurrently are in arbitrary order).
+// Error, Unwrap and EncodeJSON methods work in the same manner as Errors type.
+// They read the merged list of errors, and only errors added after the last read are merged into the list
+// (so reading after each Add call costs in proportion to number of added errors, not all errors).
+// Zero value of Collector is ready to use.
+type Collector struct {
+	seq    atomic.Uint64
+	count  atomic.Uint64
+	mu     sync.Mutex // lock for merging shards into cache
+	cache  atomic.Pointer[collectorSnapshot]
+	shards [collectorShards]collectorShard
+}
+
+// collectorShard is a shard of Collector.
+// Errors in list are moved to snapshot when merged.
+type collectorShard struct {
+	mu   sync.Mutex
+	list []collectedError
+	_    [32]byte // padding to avoid false sharing
+}
+
+// collectedError is an error with sequence number in Collector.
+type collectedError struct {
+	seq uint64
+	err error
+}
+
+// collectorSnapshot is merged list of errors in Collector.
+// errs is append-only: later snapshot may share the backing array, but never modifies errs[:len(errs)].
+type collectorSnapshot struct {
+	errs []error
+}
+
+var _ error = (*Collector)(nil)          //Collector type is compatible with error interface
+var _ fmt.Stringer = (*Collector)(nil)   //Collector type is compatible with fmt.Stringer interface
+var _ fmt.GoStringer = (*Collector)(nil) //Collector type is compatible with fmt.GoStringer interface
+var _ fmt.Formatter = (*Collector)(nil)  //Collector type is compatible with fmt.Formatter interface
+var _ json.Marshaler = (*Collector)(nil) //Collector type is compatible with json.Marshaler interface
+
+// NewCollector function returns Collector instance with errors.
+func NewCollector(errlist ...error) *Collector {
+	c := &Collector{}
+	c.Add(errlist...)
+	return c
+}
+
+// Add method adds errors to Collector. It is safe to call from multiple goroutines.
+func (c *Collector) Add(errlist ...error) {
+	if c == nil {
+		return
+	}
+	for _, err := range errlist {
+		if err == nil {
+			continue
+		}
+		seq := c.seq.Add(1)
+		shard := &c.shards[seq%collectorShards]
+		shard.mu.Lock()
+		shard.list = append(shard.list, collectedError{seq: seq, err: err})
+		c.count.Add(1) // counted in the lock, so merged list never has more errors than Len method
+		shard.mu.Unlock()
+	}
+}
+
+// Len method returns number of errors in Collector.
+func (c *Collector) Len() int {
+	if c == nil {
+		return 0
+	}
+	return int(c.count.Load())
+}
+
+// ErrorOrNil method returns this as a error type.
+func (c *Collector) ErrorOrNil() error {
+	if c.Len() == 0 {
+		return nil
+	}
+	return c
+}
+
+// snapshot returns merged list of errors (must not be modified).
+// Errors added concurrently with the call may not be in the list yet.
+func (c *Collector) snapshot() []error {
+	if c == nil {
+		return nil
+	}
+	count := c.count.Load()
+	if count == 0 {
+		return nil
+	}
+	if sn := c.cache.Load(); sn != nil && uint64(len(sn.errs)) >= count {
+		return sn.errs
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var errlist []error
+	if sn := c.cache.Load(); sn != nil {
+		if uint64(len(sn.errs)) >= c.count.Load() {
+			return sn.errs
+		}
+		errlist = sn.errs
+	}
+	added := []collectedError{}
+	for i := range c.shards {
+		shard := &c.shards[i]
+		shard.mu.Lock()
+		added = append(added, shard.list...)
+		for j := range shard.list {
+			shard.list[j] = collectedError{} // release reference to error
+		}
+		shard.list = shard.list[:0]
+		shard.mu.Unlock()
+	}
+	sort.Slice(added, func(i, j int) bool { return added[i].seq < added[j].seq })
+	for _, ce := range added {
+		errlist = append(errlist, ce.err)
+	}
+	c.cache.Store(&collectorSnapshot{errs: errlist})
+	return errlist
+}
+
+// Error method returns error message.
+// This method is a implementation of error interface.
+func (c *Collector) Error() string {
+	errlist := c.snapshot()
+	if len(errlist) == 0 {
+		return nilAngleString
+	}
+	var b []byte
+	for i, err := range errlist {
+		if i > 0 {
+			b = append(b, '\n')
+		}
+		b = append(b, err.Error()...)
+	}
+	return string(b)
+}
+
+// String method returns error message.
+// This method is a implementation of fmt.Stringer interface.
+func (c *Collector) String() string {
+	return c.Error()
+}
+
+// GoString method returns serialize string of Collector.
+// This method is a implementation of fmt.GoStringer interface.
+func (c *Collector) GoString() string {
+	errlist := c.snapshot()
+	if len(errlist) == 0 {
+		return nilAngleString
+	}
+	return fmt.Sprintf("%T{Errs:%#v}", c, errlist)
+}
+
+// MarshalJSON method returns serialize string of Collector with JSON format.
+// This method is implementation of json.Marshaler interface.
+func (c *Collector) MarshalJSON() ([]byte, error) {
+	return []byte(c.EncodeJSON()), nil
+}
+
+// Format method returns formatted string of Collector instance.
+// This method is a implementation of fmt.Formatter interface.
+func (c *Collector) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case s.Flag('#'):
+			_, _ = strings.NewReader(c.GoString()).WriteTo(s)
+		case s.Flag('+'):
+			_, _ = strings.NewReader(c.EncodeJSON()).WriteTo(s)
+		default:
+			_, _ = strings.NewReader(c.Error()).WriteTo(s)
+		}
+	case 's':
+		_, _ = strings.NewReader(c.String()).WriteTo(s)
+	default:
+		fmt.Fprintf(s, `%%!%c(%s)`, verb, c.GoString())
+	}
+}
+
+// EncodeJSON method returns serialize string of Collector with JSON format.
+func (c *Collector) EncodeJSON() string {
+	if c == nil {
+		return "null"
+	}
+	elms := []string{}
+	elms = append(elms, strings.Join([]string{`"Type":`, strconv.Quote(reflect.TypeOf(c).String())}, ""))
+	if errlist := c.snapshot(); len(errlist) > 0 {
+		elms2 := []string{}
+		for _, err := range errlist {
+			msgBuf := &bytes.Buffer{}
+			json.HTMLEscape(msgBuf, []byte(EncodeJSON(err)))
+			elms2 = append(elms2, msgBuf.String())
+		}
+		elms = append(elms, strings.Join([]string{`"Errs":[`, strings.Join(elms2, ","), "]"}, ""))
+	}
+	return strings.Join([]string{"{", strings.Join(elms, ","), "}"}, "")
+}
+
+// Unwrap method returns error list in Collector instance.
+// The list is shared with Collector instance without copying (errors.Is and errors.As functions call it repeatedly),
+// so it must not be modified. Appending to the list does not affect Collector instance.
+// This method is used in errors.Unwrap function.
+func (c *Collector) Unwrap() []error {
+	errlist := c.snapshot()
+	if len(errlist) == 0 {
+		return nil
+	}
+	return errlist[:len(errlist):len(errlist)]
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
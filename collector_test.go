@@ -0,0 +1,202 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestCollector(t *testing.T) {
+	testCases := []struct {
+		errs     []error
+		msg      string
+		json     string
+		isEOF    bool
+		errOrNil bool
+	}{
+		{errs: nil, msg: nilAngleString, json: `{"Type":"*errs.Collector"}`, isEOF: false, errOrNil: false},
+		{errs: []error{nil}, msg: nilAngleString, json: `{"Type":"*errs.Collector"}`, isEOF: false, errOrNil: false},
+		{errs: []error{io.EOF, nil, os.ErrInvalid}, msg: "EOF\ninvalid argument", json: `{"Type":"*errs.Collector","Errs":[{"Type":"*errors.errorString","Msg":"EOF"},{"Type":"*errors.errorString","Msg":"invalid argument"}]}`, isEOF: true, errOrNil: true},
+		{errs: []error{Wrap(io.EOF)}, msg: "EOF", json: `{"Type":"*errs.Collector","Errs":[{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"EOF"},"Context":{"function":"github.com/goark/errs.TestCollector"}}]}`, isEOF: true, errOrNil: true},
+	}
+
+	for _, tc := range testCases {
+		c := NewCollector(tc.errs...)
+		if got := c.Error(); got != tc.msg {
+			t.Errorf("Collector.Error() is %q, want %q", got, tc.msg)
+		}
+		if got := fmt.Sprintf("%+v", c); got != tc.json {
+			t.Errorf("Collector.EncodeJSON() is %v, want %v", got, tc.json)
+		}
+		if got := errors.Is(c, io.EOF); got != tc.isEOF {
+			t.Errorf("errors.Is(Collector, io.EOF) is %v, want %v", got, tc.isEOF)
+		}
+		if got := c.ErrorOrNil() != nil; got != tc.errOrNil {
+			t.Errorf("Collector.ErrorOrNil() != nil is %v, want %v", got, tc.errOrNil)
+		}
+		es := &Errors{}
+		es.Add(tc.errs...)
+		if got, want := c.Error(), es.Error(); got != want {
+			t.Errorf("Collector.Error() is %q, want the same as Errors.Error() %q", got, want)
+		}
+		res, err := DecodeJSON([]byte(tc.json))
+		if err != nil {
+			t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", tc.json, err)
+			continue
+		}
+		if got := EncodeJSON(res); got != tc.json {
+			t.Errorf("EncodeJSON(DecodeJSON(%v)) is %v, want %v", tc.json, got, tc.json)
+		}
+	}
+}
+
+func TestCollectorNil(t *testing.T) {
+	var c *Collector
+	c.Add(io.EOF)
+	if c.Len() != 0 || c.ErrorOrNil() != nil || c.Unwrap() != nil || c.Error() != nilAngleString || c.EncodeJSON() != "null" {
+		t.Errorf("nil Collector is %v, want empty", c)
+	}
+}
+
+func TestCollectorConcurrent(t *testing.T) {
+	c := &Collector{}
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				c.Add(fmt.Errorf("error %d-%d", i, j))
+				_ = c.Unwrap()
+			}
+		}()
+	}
+	wg.Wait()
+	if got := c.Len(); got != 1000 {
+		t.Errorf("Collector.Len() is %v, want %v", got, 1000)
+	}
+	if got := len(c.Unwrap()); got != 1000 {
+		t.Errorf("len(Collector.Unwrap()) is %v, want %v", got, 1000)
+	}
+}
+
+func TestCollectorOrder(t *testing.T) {
+	c := &Collector{}
+	want := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		c.Add(fmt.Errorf("error %d", i))
+		want = append(want, fmt.Sprintf("error %d", i))
+		if i%10 == 0 {
+			_ = c.Unwrap() // refresh cache
+		}
+	}
+	got := c.Unwrap()
+	if len(got) != len(want) {
+		t.Fatalf("len(Collector.Unwrap()) is %v, want %v", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Error() != want[i] {
+			t.Errorf("Collector.Unwrap()[%d] is %v, want %v", i, got[i], want[i])
+		}
+	}
+	_ = append(got, io.EOF)
+	c.Add(io.ErrUnexpectedEOF)
+	if got := c.Unwrap(); len(got) != len(want)+1 || got[len(want)] != io.ErrUnexpectedEOF {
+		t.Errorf("Collector.Unwrap() after append to previous list is %v, want %v at last", got, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestCollectorLenConsistency(t *testing.T) {
+	c := &Collector{}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				c.Add(io.EOF)
+			}
+		}()
+	}
+	for i := 0; i < 1000; i++ {
+		errlist := c.Unwrap()
+		if n := c.Len(); len(errlist) > n {
+			t.Fatalf("len(Collector.Unwrap()) is %v, want <= Collector.Len() (%v)", len(errlist), n)
+		}
+	}
+	wg.Wait()
+	if got := len(c.Unwrap()); got != 8000 {
+		t.Errorf("len(Collector.Unwrap()) is %v, want %v", got, 8000)
+	}
+}
+
+// benchParallelism is multiplier of GOMAXPROCS for goroutines in parallel benchmarks.
+// Contention of Errors and Collector types differs with number of CPUs, so compare them with -cpu flag:
+//
+//	go test -run none -bench Parallel -cpu 1,4,16
+const benchParallelism = 4
+
+func BenchmarkErrorsAddParallel(b *testing.B) {
+	es := &Errors{}
+	b.SetParallelism(benchParallelism)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			es.Add(io.EOF)
+		}
+	})
+}
+
+func BenchmarkCollectorAddParallel(b *testing.B) {
+	c := &Collector{}
+	b.SetParallelism(benchParallelism)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Add(io.EOF)
+		}
+	})
+}
+
+func BenchmarkErrorsAddUnwrapParallel(b *testing.B) {
+	es := Join(io.EOF, io.ErrUnexpectedEOF).(*Errors)
+	b.SetParallelism(benchParallelism)
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			if i%100 == 0 {
+				es.Add(io.EOF)
+			}
+			_ = errors.Is(es, os.ErrInvalid)
+		}
+	})
+}
+
+func BenchmarkCollectorAddUnwrapParallel(b *testing.B) {
+	c := NewCollector(io.EOF, io.ErrUnexpectedEOF)
+	b.SetParallelism(benchParallelism)
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			if i%100 == 0 {
+				c.Add(io.EOF)
+			}
+			_ = errors.Is(c, os.ErrInvalid)
+		}
+	})
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
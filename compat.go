@@ -0,0 +1,100 @@
+package errs
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+var _ xerrors.Formatter = (*Error)(nil) //Error type is compatible with xerrors.Formatter interface
+
+// FormatError method prints the message of this level and returns the next error in chain.
+// If p.Detail() is true, context values are printed too.
+// This method is implementation of xerrors.Formatter interface (used in xerrors.FormatError function).
+func (e *Error) FormatError(p xerrors.Printer) error {
+	if e == nil {
+		p.Print(nilAngleString)
+		return nil
+	}
+	if e.msgFlag || e.Cause == nil {
+		p.Print(e.Error())
+	} else if msg := e.Err.Error(); len(msg) > 0 {
+		p.Print(msg)
+	}
+	if p.Detail() && len(e.Context) > 0 {
+		keys := make([]string, 0, len(e.Context))
+		for k := range e.Context {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p.Printf("\n    %s: %v", k, e.Context[k])
+		}
+	}
+	if e.msgFlag {
+		return nil
+	}
+	return e.Cause
+}
+
+// causer is an interface of errors with Cause() method (github.com/pkg/errors package).
+type causer interface {
+	Cause() error
+}
+
+// unwrapCause returns cause error by Cause() method (github.com/pkg/errors package).
+func unwrapCause(err error) error {
+	if c, ok := err.(causer); ok {
+		if cause := c.Cause(); cause != err {
+			return cause
+		}
+	}
+	return nil
+}
+
+// stackTraceOf returns stack trace by StackTrace() method (github.com/pkg/errors package)
+// as []string of "function file:line". pkg/errors.StackTrace type is []Frame (Frame is uintptr of program counter),
+// so the method is called by reflection to avoid dependency on the package.
+func stackTraceOf(err error) ([]string, bool) {
+	m := reflect.ValueOf(err).MethodByName("StackTrace")
+	if !m.IsValid() {
+		return nil, false
+	}
+	typ := m.Type()
+	if typ.NumIn() != 0 || typ.NumOut() != 1 || typ.Out(0).Kind() != reflect.Slice || typ.Out(0).Elem().Kind() != reflect.Uintptr {
+		return nil, false
+	}
+	frames := m.Call(nil)[0]
+	stack := make([]string, 0, frames.Len())
+	for i := 0; i < frames.Len(); i++ {
+		pc := uintptr(frames.Index(i).Uint()) - 1
+		fn := runtime.FuncForPC(pc)
+		if fn == nil {
+			stack = append(stack, fmt.Sprintf("unknown %#x", pc))
+			continue
+		}
+		file, line := fn.FileLine(pc)
+		stack = append(stack, strings.Join([]string{fn.Name(), " ", file, ":", strconv.Itoa(line)}, ""))
+	}
+	return stack, true
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,106 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+
+	"golang.org/x/xerrors"
+)
+
+// pkgFrame and pkgStackTrace are the same types as Frame and StackTrace in github.com/pkg/errors package.
+type pkgFrame uintptr
+type pkgStackTrace []pkgFrame
+
+// pkgWithStack is an error like withStack in github.com/pkg/errors package (v0.8, no Unwrap method).
+type pkgWithStack struct {
+	error
+	stack []uintptr
+}
+
+func newPkgWithStack(err error) error {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(2, pcs)
+	return &pkgWithStack{error: err, stack: pcs[:n]}
+}
+
+func (w *pkgWithStack) Cause() error { return w.error }
+func (w *pkgWithStack) StackTrace() pkgStackTrace {
+	f := make([]pkgFrame, 0, len(w.stack))
+	for _, pc := range w.stack {
+		f = append(f, pkgFrame(pc))
+	}
+	return f
+}
+
+func TestPkgErrorsCause(t *testing.T) {
+	err := newPkgWithStack(io.EOF)
+	if got := Unwraps(err); len(got) != 1 || got[0] != io.EOF {
+		t.Errorf("Unwraps(%v) is %v, want [%v]", err, got, io.EOF)
+	}
+	fields := ExtraFields(err)
+	stack, ok := fields["StackTrace"].([]string)
+	if !ok || len(stack) == 0 {
+		t.Errorf("ExtraFields(%v) is %v, want StackTrace", err, fields)
+	} else if !strings.HasPrefix(stack[0], "github.com/goark/errs.TestPkgErrorsCause ") {
+		t.Errorf("ExtraFields(%v)[\"StackTrace\"][0] is %v, want this function", err, stack[0])
+	}
+	json := EncodeJSON(Wrap(err))
+	for _, want := range []string{`"Type":"*errs.pkgWithStack"`, `"StackTrace":["github.com/goark/errs.TestPkgErrorsCause `, `"Cause":{"Type":"*errors.errorString","Msg":"EOF"}`} {
+		if !strings.Contains(json, want) {
+			t.Errorf("EncodeJSON() is %v, want to contain %v", json, want)
+		}
+	}
+	res, e := DecodeJSON([]byte(json))
+	if e != nil {
+		t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", json, e)
+	} else if got := EncodeJSON(res); got != json {
+		t.Errorf("EncodeJSON(DecodeJSON(%v)) is %v, want %v", json, got, json)
+	}
+}
+
+func TestFormatError(t *testing.T) {
+	testCases := []struct {
+		err    error
+		str    string
+		detail []string
+	}{
+		{err: New("foo", WithCause(io.EOF)), str: "foo: EOF", detail: []string{"foo", "function: github.com/goark/errs.TestFormatError", "EOF"}},
+		{err: xerrors.Errorf("bar: %w", Wrap(io.EOF, WithContext("code", 1))), str: "bar: EOF", detail: []string{"bar", "code: 1", "EOF"}},
+		{err: Errorf("baz: %w", io.EOF), str: "baz: EOF", detail: []string{"baz: EOF"}},
+	}
+
+	for _, tc := range testCases {
+		outer := xerrors.Errorf("outer: %w", tc.err)
+		if got := fmt.Sprintf("%v", outer); got != "outer: "+tc.str {
+			t.Errorf("FormatError(%v) is %q, want %q", tc.err, got, "outer: "+tc.str)
+		}
+		got := fmt.Sprintf("%+v", outer)
+		for _, want := range tc.detail {
+			if !strings.Contains(got, want) {
+				t.Errorf("FormatError(%v) with detail is %q, want to contain %q", tc.err, got, want)
+			}
+		}
+		if !errors.Is(tc.err, io.EOF) {
+			t.Errorf("errors.Is(%v, io.EOF) is false, want true", tc.err)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,149 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ContextExtractor type is a function that extracts context (key/value) data of error from context.Context.
+// Empty key means that there is no data.
+type ContextExtractor func(ctx context.Context) (string, interface{})
+
+// extractorEntry is a registered ContextExtractor function.
+type extractorEntry struct {
+	id uint64
+	fn ContextExtractor
+}
+
+// extractors is copy-on-write registry of ContextExtractor functions.
+var extractors struct {
+	mu   sync.RWMutex
+	seq  uint64
+	list []extractorEntry
+}
+
+// RegisterExtractor function registers ContextExtractor functions used in WithCtx, NewCtx and WrapCtx functions.
+// It returns function to unregister the functions.
+func RegisterExtractor(fns ...ContextExtractor) func() {
+	extractors.mu.Lock()
+	defer extractors.mu.Unlock()
+	ids := map[uint64]bool{}
+	list := make([]extractorEntry, 0, len(extractors.list)+len(fns))
+	list = append(list, extractors.list...)
+	for _, fn := range fns {
+		if fn != nil {
+			extractors.seq++
+			ids[extractors.seq] = true
+			list = append(list, extractorEntry{id: extractors.seq, fn: fn})
+		}
+	}
+	extractors.list = list
+	var once sync.Once
+	return func() {
+		once.Do(func() { removeExtractors(ids) })
+	}
+}
+
+// removeExtractors unregisters ContextExtractor functions by ids.
+func removeExtractors(ids map[uint64]bool) {
+	if len(ids) == 0 {
+		return
+	}
+	extractors.mu.Lock()
+	defer extractors.mu.Unlock()
+	list := make([]extractorEntry, 0, len(extractors.list))
+	for _, x := range extractors.list {
+		if !ids[x.id] {
+			list = append(list, x)
+		}
+	}
+	extractors.list = list
+}
+
+// registeredExtractors returns registered ContextExtractor functions.
+func registeredExtractors() []extractorEntry {
+	extractors.mu.RLock()
+	defer extractors.mu.RUnlock()
+	return extractors.list
+}
+
+// WithCtx function returns ErrorContextFunc function value.
+// This function applies all registered ContextExtractor functions (context data already set are not overwritten).
+// If ctx is already done, context.Cause(ctx) and ctx.Err() are attached as the cause
+// (with the wrapped error if it is used in Wrap function, so both are found by errors.Is and errors.As functions).
+func WithCtx(ctx context.Context) ErrorContextFunc {
+	return func(e *Error) {
+		if e == nil || ctx == nil {
+			return
+		}
+		for _, x := range registeredExtractors() {
+			name, value := x.fn(ctx)
+			if len(name) == 0 {
+				continue
+			}
+			if _, ok := e.Context[name]; !ok {
+				_ = e.SetContext(name, value)
+			}
+		}
+		ctxErr := ctx.Err()
+		if ctxErr == nil {
+			return
+		}
+		cause := context.Cause(ctx)
+		if cause != nil && !errors.Is(cause, ctxErr) {
+			cause = Join(cause, ctxErr)
+		}
+		if errors.Is(e.Err, cause) || errors.Is(e.Cause, cause) {
+			return
+		}
+		switch {
+		case e.Cause == nil && e.wrapFlag && !e.msgFlag:
+			// The wrapped error and the cause of ctx are both causes (in the same manner as Errorf function),
+			// so the wrapped error is still found by errors.As function.
+			wrapped := e.Err
+			_ = e.SetCause(cause)
+			e.Err = errors.New(e.Error())
+			e.Cause = Join(wrapped, cause)
+			e.wrapFlag = false
+			e.msgFlag = true
+		case e.Cause == nil:
+			_ = e.SetCause(cause)
+		default:
+			_ = e.SetCause(Join(e.Cause, cause))
+		}
+	}
+}
+
+// NewCtx function returns an error instance with message and context informations.
+// Context data are extracted from ctx by registered ContextExtractor functions (see WithCtx function).
+func NewCtx(ctx context.Context, msg string, opts ...ErrorContextFunc) error {
+	if len(msg) == 0 {
+		return nil
+	}
+	return newError(errors.New(msg), false, 2, append(opts[:len(opts):len(opts)], WithCtx(ctx))...)
+}
+
+// WrapCtx function returns a wrapping error instance with context informations.
+// Context data are extracted from ctx by registered ContextExtractor functions (see WithCtx function).
+func WrapCtx(ctx context.Context, err error, opts ...ErrorContextFunc) error {
+	if err == nil {
+		return nil
+	}
+	return newError(err, true, 2, append(opts[:len(opts):len(opts)], WithCtx(ctx))...)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
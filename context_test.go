@@ -0,0 +1,120 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+type testCtxKey struct{}
+
+func registerTestExtractor() func() {
+	return RegisterExtractor(func(ctx context.Context) (string, interface{}) {
+		if v, ok := ctx.Value(testCtxKey{}).(string); ok {
+			return "request_id", v
+		}
+		return "", nil
+	})
+}
+
+func TestNewCtx(t *testing.T) {
+	defer registerTestExtractor()()
+	ctx := context.WithValue(context.Background(), testCtxKey{}, "req-1")
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	customCause, cancelCause := context.WithCancelCause(ctx)
+	cancelCause(io.EOF)
+
+	testCases := []struct {
+		err  error
+		json string
+	}{
+		{err: NewCtx(ctx, ""), json: "null"},
+		{err: WrapCtx(ctx, nil), json: "null"},
+		{
+			err:  NewCtx(ctx, "error", WithContext("foo", "bar")),
+			json: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"error"},"Context":{"foo":"bar","function":"github.com/goark/errs.TestNewCtx","request_id":"req-1"}}`,
+		},
+		{
+			err:  NewCtx(ctx, "error", WithContext("request_id", "overwritten")),
+			json: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"error"},"Context":{"function":"github.com/goark/errs.TestNewCtx","request_id":"overwritten"}}`,
+		},
+		{
+			err:  WrapCtx(context.Background(), os.ErrInvalid),
+			json: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"invalid argument"},"Context":{"function":"github.com/goark/errs.TestNewCtx"}}`,
+		},
+		{
+			err:  NewCtx(canceled, "error"),
+			json: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"error"},"Context":{"function":"github.com/goark/errs.TestNewCtx","request_id":"req-1"},"Cause":{"Type":"*errors.errorString","Msg":"context canceled"}}`,
+		},
+		{
+			err:  WrapCtx(canceled, context.Canceled),
+			json: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"context canceled"},"Context":{"function":"github.com/goark/errs.TestNewCtx","request_id":"req-1"}}`,
+		},
+		{
+			err:  NewCtx(canceled, "error", WithCause(os.ErrInvalid)),
+			json: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"error"},"Context":{"function":"github.com/goark/errs.TestNewCtx","request_id":"req-1"},"Cause":{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"invalid argument"},{"Type":"*errors.errorString","Msg":"context canceled"}]}}`,
+		},
+		{
+			err:  NewCtx(customCause, "error"),
+			json: `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"error"},"Context":{"function":"github.com/goark/errs.TestNewCtx","request_id":"req-1"},"Cause":{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"EOF"},{"Type":"*errors.errorString","Msg":"context canceled"}]}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := EncodeJSON(tc.err); got != tc.json {
+			t.Errorf("EncodeJSON(%v) is %v, want %v", tc.err, got, tc.json)
+		}
+	}
+	if err := NewCtx(customCause, "error"); !errors.Is(err, io.EOF) || !errors.Is(err, context.Canceled) {
+		t.Errorf("errors.Is(%v) is false, want true", err)
+	}
+}
+
+func TestWrapCtxDone(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := WrapCtx(canceled, &os.PathError{Op: "open", Path: "x", Err: os.ErrNotExist})
+	if got, want := err.Error(), "open x: file does not exist: context canceled"; got != want {
+		t.Errorf("Error() is %v, want %v", got, want)
+	}
+	var pathErr *os.PathError
+	if !errors.As(err, &pathErr) {
+		t.Errorf("errors.As(%v, *os.PathError) is false, want true", err)
+	}
+	for _, target := range []error{os.ErrNotExist, context.Canceled} {
+		if !errors.Is(err, target) {
+			t.Errorf("errors.Is(%v, %v) is false, want true", err, target)
+		}
+	}
+}
+
+func TestRegisterExtractor(t *testing.T) {
+	ctx := context.WithValue(context.Background(), testCtxKey{}, "req-1")
+	unregister := registerTestExtractor()
+	if _, ok := NewCtx(ctx, "error").(*Error).Context["request_id"]; !ok {
+		t.Errorf("Context[request_id] is not found, want found")
+	}
+	unregister()
+	unregister() // no-op
+	if v, ok := NewCtx(ctx, "error").(*Error).Context["request_id"]; ok {
+		t.Errorf("Context[request_id] is %v, want not found", v)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,298 @@
+package errs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// RemoteError type is an error instance restored from serialized data of foreign (not errs package) error type.
+type RemoteError struct {
+	Type   string
+	Msg    string
+	Fields map[string]interface{} // extra fields by the encoder of original error type (see RegisterEncoder function)
+	Causes []error
+	multi  bool
+}
+
+var _ error = (*RemoteError)(nil)          //RemoteError type is compatible with error interface
+var _ json.Marshaler = (*RemoteError)(nil) //RemoteError type is compatible with json.Marshaler interface
+var _ json.Unmarshaler = (*Error)(nil)     //Error type is compatible with json.Unmarshaler interface
+var _ json.Unmarshaler = (*Errors)(nil)    //Errors type is compatible with json.Unmarshaler interface
+
+// Error method returns error message.
+// This method is a implementation of error interface.
+func (e *RemoteError) Error() string {
+	if e == nil {
+		return nilAngleString
+	}
+	return e.Msg
+}
+
+// Unwrap method returns cause errors in RemoteError instance.
+// This method is used in errors.Is and errors.As functions.
+func (e *RemoteError) Unwrap() []error {
+	if e == nil || len(e.Causes) == 0 {
+		return nil
+	}
+	cpy := make([]error, len(e.Causes))
+	copy(cpy, e.Causes)
+	return cpy
+}
+
+// MarshalJSON method returns serialize string of RemoteError with JSON format.
+// The output is the same as the serialized data of original error.
+// This method is implementation of json.Marshaler interface.
+func (e *RemoteError) MarshalJSON() ([]byte, error) {
+	return []byte(e.EncodeJSON()), nil
+}
+
+// EncodeJSON method returns serialize string of RemoteError with JSON format.
+func (e *RemoteError) EncodeJSON() string {
+	if e == nil {
+		return "null"
+	}
+	elms := []string{}
+	elms = append(elms, strings.Join([]string{`"Type":`, strconv.Quote(e.Type)}, ""))
+	msgBuf := &bytes.Buffer{}
+	json.HTMLEscape(msgBuf, bytes.Join([][]byte{[]byte(`"Msg":`), []byte(strconv.Quote(e.Msg))}, []byte{}))
+	elms = append(elms, msgBuf.String())
+	if fields := encodeFields(e.Fields); len(fields) > 0 {
+		elms = append(elms, strings.Join([]string{`"Fields":`, fields}, ""))
+	}
+	switch {
+	case len(e.Causes) == 1 && !e.multi:
+		elms = append(elms, strings.Join([]string{`"Cause":`, EncodeJSON(e.Causes[0])}, ""))
+	case len(e.Causes) > 0:
+		causes := []string{}
+		for _, c := range e.Causes {
+			causes = append(causes, EncodeJSON(c))
+		}
+		elms = append(elms, strings.Join([]string{`"Cause":[`, strings.Join(causes, ","), "]"}, ""))
+	}
+	return strings.Join([]string{"{", strings.Join(elms, ","), "}"}, "")
+}
+
+// jsonError is intermediate structure for decoding error instance.
+type jsonError struct {
+	Type        string                 `json:"Type"`
+	Msg         *string                `json:"Msg"`
+	Err         json.RawMessage        `json:"Err"`
+	Context     map[string]interface{} `json:"Context"`
+	Public      string                 `json:"Public"`
+	Fields      map[string]interface{} `json:"Fields"`
+	Cause       json.RawMessage        `json:"Cause"`
+	Errs        []json.RawMessage      `json:"Errs"`
+	Items       json.RawMessage        `json:"Items"`
+	Occurrences []occurrenceData       `json:"Occurrences"`
+	Dropped     int                    `json:"Dropped"`
+}
+
+// DecodeJSON function restores error instance from JSON data encoded by EncodeJSON function.
+// *errs.Error, *errs.Errors and *errs.Collector are restored as themselves,
+// and other (foreign) error types are restored as *errs.RemoteError.
+//
+// JSON data does not have the distinction between New and Wrap functions,
+// so Err field of restored *errs.Error is regarded as wrapped error unless it is a simple message (*errors.errorString).
+func DecodeJSON(data []byte) (error, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	var je jsonError
+	if err := json.Unmarshal(data, &je); err != nil {
+		return nil, Wrap(err)
+	}
+	if len(je.Type) == 0 {
+		return nil, New("no error type in JSON data", WithContext("data", string(data)))
+	}
+	switch je.Type {
+	case typeNameError:
+		e := &Error{}
+		if err := e.decode(&je); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case typeNameErrors:
+		es := &Errors{}
+		if err := es.decode(&je); err != nil {
+			return nil, err
+		}
+		return es, nil
+	case typeNameCollector:
+		es := &Errors{}
+		if err := es.decode(&je); err != nil {
+			return nil, err
+		}
+		return NewCollector(es.errs...), nil
+	}
+	return decodeRemoteError(&je)
+}
+
+// UnmarshalJSON method restores Error instance from JSON data encoded by EncodeJSON method.
+// This method is implementation of json.Unmarshaler interface.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	if e == nil {
+		return New("nil receiver")
+	}
+	var je jsonError
+	if err := json.Unmarshal(data, &je); err != nil {
+		return Wrap(err)
+	}
+	if je.Type != typeNameError {
+		return New("type mismatch", WithContext("type", je.Type))
+	}
+	return e.decode(&je)
+}
+
+// UnmarshalJSON method restores Errors instance from JSON data encoded by EncodeJSON method.
+// This method is implementation of json.Unmarshaler interface.
+func (es *Errors) UnmarshalJSON(data []byte) error {
+	if es == nil {
+		return New("nil receiver")
+	}
+	var je jsonError
+	if err := json.Unmarshal(data, &je); err != nil {
+		return Wrap(err)
+	}
+	if je.Type != typeNameErrors {
+		return New("type mismatch", WithContext("type", je.Type))
+	}
+	return es.decode(&je)
+}
+
+func (e *Error) decode(je *jsonError) error {
+	werr, err := DecodeJSON(je.Err)
+	if err != nil {
+		return err
+	}
+	cause, err := DecodeJSON(je.Cause)
+	if err != nil {
+		return err
+	}
+	e.Err = werr
+	e.Cause = cause
+	e.Context = je.Context
+	e.msgFlag = je.Msg != nil // message is not composed of Err and Cause (see Error.EncodeJSON method)
+	e.public = je.Public
+	if re, ok := werr.(*RemoteError); !ok || re.Type != typeNameErrorString || len(re.Causes) > 0 {
+		e.wrapFlag = true
+	}
+	return nil
+}
+
+func (es *Errors) decode(je *jsonError) error {
+	errlist := make([]error, 0, len(je.Errs))
+	for _, raw := range je.Errs {
+		err, e := DecodeJSON(raw)
+		if e != nil {
+			return e
+		}
+		if err != nil {
+			errlist = append(errlist, err)
+		}
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	es.errs = errlist
+	es.head = 0
+	es.dropped = je.Dropped
+	es.restoreOccurs(je.Occurrences)
+	return nil
+}
+
+func decodeRemoteError(je *jsonError) (error, error) {
+	e := &RemoteError{Type: je.Type, Fields: je.Fields}
+	if je.Msg != nil {
+		e.Msg = *je.Msg
+	}
+	if items := bytes.TrimSpace(je.Items); len(items) > 0 && items[0] == '{' {
+		causes, err := decodeItems(items)
+		if err != nil {
+			return nil, err
+		}
+		e.Causes = causes
+		e.multi = true
+		return e, nil
+	}
+	raw := bytes.TrimSpace(je.Cause)
+	if len(raw) == 0 {
+		raw = bytes.TrimSpace(je.Err) // custom json.Marshaler may output cause as "Err"
+	}
+	if len(raw) > 0 && raw[0] == '[' {
+		var list []json.RawMessage
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, Wrap(err)
+		}
+		for _, r := range list {
+			c, err := DecodeJSON(r)
+			if err != nil {
+				return nil, err
+			}
+			if c != nil {
+				e.Causes = append(e.Causes, c)
+			}
+		}
+		e.multi = true
+		return e, nil
+	}
+	c, err := DecodeJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		e.Causes = []error{c}
+	}
+	return e, nil
+}
+
+// decodeItems restores errors in "Items" object (see Batch.EncodeJSON method) in order of the keys.
+func decodeItems(data []byte) ([]error, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil { // '{'
+		return nil, Wrap(err)
+	}
+	var causes []error
+	for dec.More() {
+		if _, err := dec.Token(); err != nil { // key
+			return nil, Wrap(err)
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, Wrap(err)
+		}
+		c, err := DecodeJSON(raw)
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			causes = append(causes, c)
+		}
+	}
+	return causes, nil
+}
+
+var (
+	typeNameError       = reflect.TypeOf((*Error)(nil)).String()
+	typeNameErrors      = reflect.TypeOf((*Errors)(nil)).String()
+	typeNameCollector   = reflect.TypeOf((*Collector)(nil)).String()
+	typeNameErrorString = reflect.TypeOf(errors.New("")).String()
+)
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,96 @@
+package errs
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	testCases := []struct {
+		err error
+	}{
+		{err: nil},
+		{err: os.ErrInvalid},
+		{err: errTest},
+		{err: wrapedErrTest},
+		{err: New("wrapped message", WithCause(os.ErrInvalid), WithContext("foo", "bar"), WithContext("num", 1))},
+		{err: Wrap(os.ErrInvalid, WithCause(errors.Join(io.EOF, io.ErrUnexpectedEOF)))},
+		{err: Join(os.ErrInvalid, Wrap(io.EOF))},
+		{err: &os.PathError{Op: "open", Path: "not-exist.txt", Err: os.ErrNotExist}},
+	}
+
+	for _, tc := range testCases {
+		want := EncodeJSON(tc.err)
+		err, e := DecodeJSON([]byte(want))
+		if e != nil {
+			t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", want, e)
+			continue
+		}
+		if got := EncodeJSON(err); got != want {
+			t.Errorf("EncodeJSON(DecodeJSON(%v)) is %v, want %v", want, got, want)
+		}
+		if tc.err == nil {
+			if err != nil {
+				t.Errorf("DecodeJSON(%v) is %v, want <nil>", want, err)
+			}
+			continue
+		}
+		if got := err.Error(); got != tc.err.Error() {
+			t.Errorf("DecodeJSON(%v).Error() is %v, want %v", want, got, tc.err.Error())
+		}
+	}
+}
+
+func TestDecodeJSONError(t *testing.T) {
+	testCases := []struct {
+		data string
+	}{
+		{data: `{`},
+		{data: `{"Msg":"no type"}`},
+		{data: `{"Type":"*errs.Error","Err":{"Msg":"no type"}}`},
+		{data: `{"Type":"*errs.Errors","Errs":[{"Msg":"no type"}]}`},
+	}
+
+	for _, tc := range testCases {
+		if _, err := DecodeJSON([]byte(tc.data)); err == nil {
+			t.Errorf("DecodeJSON(%v) is <nil>, want error", tc.data)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	err := New("wrapped message", WithCause(Join(os.ErrInvalid, io.EOF)), WithContext("foo", "bar"))
+	b, e := json.Marshal(struct{ Err error }{Err: err})
+	if e != nil {
+		t.Fatalf("json.Marshal() is \"%v\", want <nil>", e)
+	}
+	var got struct{ Err *Error }
+	if e := json.Unmarshal(b, &got); e != nil {
+		t.Fatalf("json.Unmarshal() is \"%v\", want <nil>", e)
+	}
+	if str := EncodeJSON(got.Err); str != EncodeJSON(err) {
+		t.Errorf("json.Unmarshal() is %v, want %v", str, EncodeJSON(err))
+	}
+	var es Errors
+	if e := json.Unmarshal(b, &es); e == nil {
+		t.Errorf("json.Unmarshal() to *Errors is <nil>, want error")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
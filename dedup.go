@@ -0,0 +1,185 @@
+package errs
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DedupKeyFunc type is function that returns key of error for dedup mode of Errors (see WithDedup function).
+type DedupKeyFunc func(error) string
+
+// DedupByMessage function is DedupKeyFunc that returns error message.
+func DedupByMessage(err error) string {
+	return err.Error()
+}
+
+// DedupByTypeAndMessage function is DedupKeyFunc that returns type name and error message.
+func DedupByTypeAndMessage(err error) string {
+	return TypeName(err) + ": " + err.Error()
+}
+
+// errorsDedup is dedup mode of Errors.
+type errorsDedup struct {
+	fn    DedupKeyFunc
+	index map[string]int
+}
+
+// occurrence is occurrence of error in dedup mode.
+type occurrence struct {
+	key   string
+	count int
+	first time.Time
+	last  time.Time
+}
+
+// Occurrence is occurrence of distinct error in dedup mode of Errors.
+type Occurrence struct {
+	Err   error
+	Count int
+	First time.Time
+	Last  time.Time
+}
+
+// WithDedup function returns ErrorsOption function value.
+// This function sets dedup mode: errors of the same key are stored once (the first one is representative)
+// with occurrence count and the first and last seen times.
+// If fn is nil, DedupByTypeAndMessage function is used.
+func WithDedup(fn DedupKeyFunc) ErrorsOption {
+	return func(es *Errors) {
+		if fn == nil {
+			fn = DedupByTypeAndMessage
+		}
+		es.dedup = &errorsDedup{fn: fn, index: map[string]int{}}
+		if es.occurs == nil {
+			es.occurs = []occurrence{}
+		}
+	}
+}
+
+// Occurrences method returns distinct errors with occurrence counts in dedup mode (see WithDedup function).
+// It returns nil if Errors is not in dedup mode.
+func (es *Errors) Occurrences() []Occurrence {
+	if es == nil {
+		return nil
+	}
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+	occurs := es.listOccurs()
+	if len(occurs) == 0 {
+		return nil
+	}
+	list := es.list()
+	res := make([]Occurrence, 0, len(occurs))
+	for i, occ := range occurs {
+		res = append(res, Occurrence{Err: list[i], Count: occ.count, First: occ.first, Last: occ.last})
+	}
+	return res
+}
+
+// OccurrenceCounts method returns occurrence counts of errors in the same order as Unwrap method.
+// It returns nil if occurrences are not tracked (see WithDedup function).
+func (es *Errors) OccurrenceCounts() []int {
+	if es == nil {
+		return nil
+	}
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+	occurs := es.listOccurs()
+	if len(occurs) == 0 {
+		return nil
+	}
+	counts := make([]int, 0, len(occurs))
+	for _, occ := range occurs {
+		counts = append(counts, occ.count)
+	}
+	return counts
+}
+
+// track records the occurrence of i-th error (lock must be held).
+func (es *Errors) track(i int, occ occurrence) {
+	if es.occurs == nil {
+		return
+	}
+	if i < len(es.occurs) {
+		es.occurs[i] = occ
+	} else {
+		es.occurs = append(es.occurs, occ)
+	}
+	if es.dedup != nil {
+		es.dedup.index[occ.key] = i
+	}
+}
+
+// listOccurs returns occurrences in order (lock must be held).
+func (es *Errors) listOccurs() []occurrence {
+	if es.head == 0 || len(es.occurs) == 0 {
+		return es.occurs
+	}
+	list := make([]occurrence, 0, len(es.occurs))
+	list = append(list, es.occurs[es.head:]...)
+	return append(list, es.occurs[:es.head]...)
+}
+
+// occurrenceData is occurrence of error in JSON and gob data.
+type occurrenceData struct {
+	Count int       `json:"Count"`
+	First time.Time `json:"First"`
+	Last  time.Time `json:"Last"`
+}
+
+// occurrenceDataList returns occurrences in JSON and gob data.
+func (es *Errors) occurrenceDataList() []occurrenceData {
+	if es == nil {
+		return nil
+	}
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+	occurs := es.listOccurs()
+	if len(occurs) == 0 {
+		return nil
+	}
+	list := make([]occurrenceData, 0, len(occurs))
+	for _, occ := range occurs {
+		list = append(list, occurrenceData{Count: occ.count, First: occ.first, Last: occ.last})
+	}
+	return list
+}
+
+// restoreOccurs restores occurrences from JSON and gob data (lock must be held).
+// Occurrences are ignored if the number of them does not match the number of errors.
+func (es *Errors) restoreOccurs(list []occurrenceData) {
+	es.occurs = nil
+	if len(list) == 0 || len(list) != len(es.errs) {
+		return
+	}
+	es.occurs = make([]occurrence, 0, len(list))
+	for _, d := range list {
+		es.occurs = append(es.occurs, occurrence{count: d.Count, first: d.First, last: d.Last})
+	}
+}
+
+// encodeJSON returns JSON data of occurrence.
+func (occ occurrence) encodeJSON() string {
+	return strings.Join([]string{
+		`{"Count":`, strconv.Itoa(occ.count),
+		`,"First":`, strconv.Quote(occ.first.Format(time.RFC3339Nano)),
+		`,"Last":`, strconv.Quote(occ.last.Format(time.RFC3339Nano)),
+		"}",
+	}, "")
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
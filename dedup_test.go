@@ -0,0 +1,123 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+type dedupTestError struct{ msg string }
+
+func (e *dedupTestError) Error() string { return e.msg }
+
+func TestWithDedup(t *testing.T) {
+	testCases := []struct {
+		fn     DedupKeyFunc
+		errs   []error
+		msg    string
+		counts []int
+	}{
+		{fn: nil, errs: []error{io.EOF, io.EOF, os.ErrNotExist, io.EOF}, msg: "EOF (3 times)\nfile does not exist", counts: []int{3, 1}},
+		{fn: nil, errs: []error{io.EOF, &dedupTestError{msg: "EOF"}}, msg: "EOF\nEOF", counts: []int{1, 1}},
+		{fn: DedupByMessage, errs: []error{io.EOF, &dedupTestError{msg: "EOF"}}, msg: "EOF (2 times)", counts: []int{2}},
+		{fn: func(err error) string { return strings.Fields(err.Error())[0] }, errs: []error{errors.New("foo 1"), errors.New("foo 2"), errors.New("bar 1")}, msg: "foo 1 (2 times)\nbar 1", counts: []int{2, 1}},
+	}
+
+	for _, tc := range testCases {
+		es := NewErrors(WithDedup(tc.fn))
+		es.Add(tc.errs...)
+		if got := es.Error(); got != tc.msg {
+			t.Errorf("Errors.Error() is %q, want %q", got, tc.msg)
+		}
+		if got := es.OccurrenceCounts(); !equalInts(got, tc.counts) {
+			t.Errorf("Errors.Occurrences() counts are %v, want %v", got, tc.counts)
+		}
+		for _, occ := range es.Occurrences() {
+			if occ.First.After(occ.Last) {
+				t.Errorf("Occurrence.First (%v) is after Occurrence.Last (%v)", occ.First, occ.Last)
+			}
+		}
+	}
+}
+
+func TestWithDedupIsAs(t *testing.T) {
+	es := NewErrors(WithDedup(DedupByMessage))
+	es.Add(&dedupTestError{msg: "dup"}, &dedupTestError{msg: "dup"}, io.EOF)
+	if !errors.Is(es, io.EOF) {
+		t.Errorf("errors.Is(%v, io.EOF) is false, want true", es)
+	}
+	var target *dedupTestError
+	if !errors.As(es, &target) || target.msg != "dup" {
+		t.Errorf("errors.As(%v, *dedupTestError) is false, want true", es)
+	}
+}
+
+func TestWithDedupCapacity(t *testing.T) {
+	es := NewErrors(WithDedup(nil), WithCapacity(1, KeepLast))
+	es.Add(io.EOF, io.EOF, io.ErrUnexpectedEOF, io.EOF)
+	if got, want := es.Error(), "EOF\nand 3 more"; got != want {
+		t.Errorf("Errors.Error() is %q, want %q", got, want)
+	}
+	if got := es.Dropped(); got != 3 {
+		t.Errorf("Errors.Dropped() is %v, want %v", got, 3)
+	}
+}
+
+func TestWithDedupTransport(t *testing.T) {
+	es := NewErrors(WithDedup(nil))
+	es.Add(io.EOF, io.EOF, os.ErrNotExist)
+	json := es.EncodeJSON()
+	if !strings.Contains(json, `"Occurrences":[{"Count":2,`) {
+		t.Errorf("Errors.EncodeJSON() is %v, want Occurrences", json)
+	}
+	res, err := DecodeJSON([]byte(json))
+	if err != nil {
+		t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", json, err)
+	} else if !Equal(res, es) {
+		t.Errorf("Equal(DecodeJSON(%v), Errors) is false, want true: %v", json, Diff(res, es))
+	}
+	data, err := EncodeGob(es)
+	if err != nil {
+		t.Errorf("EncodeGob(%v) is \"%v\", want <nil>", es, err)
+		return
+	}
+	if res, err = DecodeGob(data); err != nil {
+		t.Errorf("DecodeGob(%v) is \"%v\", want <nil>", es, err)
+	} else if got := EncodeJSON(res); got != json {
+		t.Errorf("EncodeJSON(DecodeGob(%v)) is %v, want %v", es, got, json)
+	}
+	plain := &Errors{}
+	plain.Add(io.EOF, os.ErrNotExist)
+	if Equal(plain, es) {
+		t.Errorf("Equal(%v, %v) is true, want false", plain, es)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
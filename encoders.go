@@ -0,0 +1,191 @@
+package errs
+
+import (
+	"encoding/json"
+	"io/fs"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"reflect"
+	"sync"
+)
+
+// encoderFunc is type-erased encoder function registered by RegisterEncoder function.
+type encoderFunc func(error) map[string]interface{}
+
+// interfaceEncoder is encoder function for interface type.
+type interfaceEncoder struct {
+	typ reflect.Type
+	fn  encoderFunc
+}
+
+// encoderRegistry is registry of encoder functions for foreign error types.
+var encoderRegistry = struct {
+	mu         sync.RWMutex
+	encoders   map[reflect.Type]encoderFunc
+	interfaces []interfaceEncoder
+}{
+	encoders: map[reflect.Type]encoderFunc{},
+}
+
+func init() {
+	RegisterEncoder(func(e *fs.PathError) map[string]interface{} {
+		return map[string]interface{}{"Op": e.Op, "Path": e.Path}
+	})
+	RegisterEncoder(func(e *os.LinkError) map[string]interface{} {
+		return map[string]interface{}{"Op": e.Op, "Old": e.Old, "New": e.New}
+	})
+	RegisterEncoder(func(e *os.SyscallError) map[string]interface{} {
+		return map[string]interface{}{"Syscall": e.Syscall}
+	})
+	RegisterEncoder(func(e *net.OpError) map[string]interface{} {
+		fields := map[string]interface{}{"Op": e.Op, "Net": e.Net}
+		if e.Source != nil {
+			fields["Source"] = e.Source.String()
+		}
+		if e.Addr != nil {
+			fields["Addr"] = e.Addr.String()
+		}
+		return fields
+	})
+	RegisterEncoder(func(e *net.DNSError) map[string]interface{} {
+		return map[string]interface{}{"Name": e.Name, "Server": e.Server, "IsTimeout": e.IsTimeout, "IsNotFound": e.IsNotFound}
+	})
+	RegisterEncoder(func(e *url.Error) map[string]interface{} {
+		return map[string]interface{}{"Op": e.Op, "URL": e.URL}
+	})
+	RegisterEncoder(func(e *exec.Error) map[string]interface{} {
+		return map[string]interface{}{"Name": e.Name}
+	})
+	RegisterEncoder(func(e *exec.ExitError) map[string]interface{} {
+		fields := map[string]interface{}{"ExitCode": e.ExitCode()}
+		if e.ProcessState != nil {
+			fields["Pid"] = e.Pid()
+		}
+		return fields
+	})
+}
+
+// RegisterEncoder function registers encoder function for foreign (not errs package) error type T.
+// The encoder returns extra fields of error instance, and the fields are output as "Fields" in EncodeJSON function
+// (and "fields" in zapobject package). If T is interface type, the encoder is used for all types implementing T.
+// The encoder for the same type is replaced, and nil encoder unregisters it.
+// Encoders for *fs.PathError, *os.LinkError, *os.SyscallError, *net.OpError, *net.DNSError, *url.Error,
+// *exec.Error, *exec.ExitError and syscall.Errno are registered in advance.
+func RegisterEncoder[T error](fn func(T) map[string]interface{}) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	var efn encoderFunc
+	if fn != nil {
+		efn = func(err error) map[string]interface{} {
+			if e, ok := err.(T); ok {
+				return fn(e)
+			}
+			return nil
+		}
+	}
+	encoderRegistry.mu.Lock()
+	defer encoderRegistry.mu.Unlock()
+	if typ.Kind() != reflect.Interface {
+		if efn == nil {
+			delete(encoderRegistry.encoders, typ)
+		} else {
+			encoderRegistry.encoders[typ] = efn
+		}
+		return
+	}
+	list := make([]interfaceEncoder, 0, len(encoderRegistry.interfaces)+1)
+	for _, ie := range encoderRegistry.interfaces {
+		if ie.typ != typ {
+			list = append(list, ie)
+		}
+	}
+	if efn != nil {
+		list = append(list, interfaceEncoder{typ: typ, fn: efn})
+	}
+	encoderRegistry.interfaces = list
+}
+
+// ExtraFields function returns extra fields of foreign error instance by the encoder registered by RegisterEncoder function.
+// For *RemoteError, it returns the fields restored from serialized data.
+// Stack trace of StackTrace() method (github.com/pkg/errors package) is returned as "StackTrace" field.
+// It returns nil for *Error, *Errors and error types without encoder.
+func ExtraFields(err error) map[string]interface{} {
+	switch e := err.(type) {
+	case nil, *Error, *Errors:
+		return nil
+	case *RemoteError:
+		if e == nil || len(e.Fields) == 0 {
+			return nil
+		}
+		fields := make(map[string]interface{}, len(e.Fields))
+		for k, v := range e.Fields {
+			fields[k] = v
+		}
+		return fields
+	}
+	typ := reflect.TypeOf(err)
+	encoderRegistry.mu.RLock()
+	fn, ok := encoderRegistry.encoders[typ]
+	if !ok {
+		for _, ie := range encoderRegistry.interfaces {
+			if typ.Implements(ie.typ) {
+				fn, ok = ie.fn, true
+				break
+			}
+		}
+	}
+	encoderRegistry.mu.RUnlock()
+	var fields map[string]interface{}
+	if ok {
+		fields = fn(err)
+	}
+	if stack, ok := stackTraceOf(err); ok {
+		if fields == nil {
+			fields = map[string]interface{}{}
+		}
+		if _, exist := fields["StackTrace"]; !exist {
+			fields["StackTrace"] = stack
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+// encodeFields returns JSON data of extra fields (empty string if no fields).
+func encodeFields(fields map[string]interface{}) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		safe := make(map[string]interface{}, len(fields))
+		for k, v := range fields {
+			if _, e := json.Marshal(v); e != nil {
+				v = contextValueString(v)
+			}
+			safe[k] = v
+		}
+		if b, err = json.Marshal(safe); err != nil {
+			return ""
+		}
+	}
+	return string(b)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,133 @@
+package errs
+
+import (
+	"encoding/json"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+type encoderTestError struct {
+	ID string
+}
+
+func (e *encoderTestError) Error() string { return "encoder test " + e.ID }
+
+type encoderTestInterface interface {
+	error
+	Temporary() bool
+}
+
+type encoderTestTemporary struct{}
+
+func (e encoderTestTemporary) Error() string   { return "temporary" }
+func (e encoderTestTemporary) Temporary() bool { return true }
+
+func TestExtraFields(t *testing.T) {
+	testCases := []struct {
+		err  error
+		want string
+	}{
+		{err: nil, want: ""},
+		{err: New("message"), want: ""},
+		{err: os.ErrInvalid, want: ""},
+		{err: &os.PathError{Op: "open", Path: "not-exist.txt", Err: os.ErrNotExist}, want: `{"Op":"open","Path":"not-exist.txt"}`},
+		{err: &net.OpError{Op: "dial", Net: "tcp", Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 80}, Err: os.ErrInvalid}, want: `{"Addr":"127.0.0.1:80","Net":"tcp","Op":"dial"}`},
+		{err: &url.Error{Op: "Get", URL: "http://example.com", Err: os.ErrInvalid}, want: `{"Op":"Get","URL":"http://example.com"}`},
+		{err: &RemoteError{Type: "*fs.PathError", Fields: map[string]interface{}{"Op": "open"}}, want: `{"Op":"open"}`},
+	}
+
+	for _, tc := range testCases {
+		if got := encodeFields(ExtraFields(tc.err)); got != tc.want {
+			t.Errorf("ExtraFields(%v) is %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestExtraFieldsExitError(t *testing.T) {
+	path, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh command is not found")
+	}
+	err = exec.Command(path, "-c", "exit 3").Run()
+	fields := ExtraFields(err)
+	if got, want := fields["ExitCode"], 3; got != want {
+		t.Errorf("ExtraFields(%v)[ExitCode] is %v, want %v", err, got, want)
+	}
+}
+
+func TestRegisterEncoder(t *testing.T) {
+	RegisterEncoder(func(e *encoderTestError) map[string]interface{} {
+		return map[string]interface{}{"ID": e.ID}
+	})
+	RegisterEncoder(func(e encoderTestInterface) map[string]interface{} {
+		return map[string]interface{}{"Temporary": e.Temporary()}
+	})
+	defer RegisterEncoder[*encoderTestError](nil)
+	defer RegisterEncoder[encoderTestInterface](nil)
+
+	testCases := []struct {
+		err  error
+		want string
+	}{
+		{err: Wrap(&encoderTestError{ID: "1"}), want: `{"Type":"*errs.Error","Err":{"Type":"*errs.encoderTestError","Msg":"encoder test 1","Fields":{"ID":"1"}},"Context":{"function":"github.com/goark/errs.TestRegisterEncoder"}}`},
+		{err: encoderTestTemporary{}, want: `{"Type":"errs.encoderTestTemporary","Msg":"temporary","Fields":{"Temporary":true}}`},
+	}
+
+	for _, tc := range testCases {
+		got := EncodeJSON(tc.err)
+		if got != tc.want {
+			t.Errorf("EncodeJSON(%v) is %v, want %v", tc.err, got, tc.want)
+		}
+		if !json.Valid([]byte(got)) {
+			t.Errorf("EncodeJSON(%v) is invalid JSON: %v", tc.err, got)
+		}
+		res, err := DecodeJSON([]byte(got))
+		if err != nil {
+			t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", got, err)
+			continue
+		}
+		if s := EncodeJSON(res); s != got {
+			t.Errorf("EncodeJSON(DecodeJSON(%v)) is %v, want %v", got, s, got)
+		}
+		if !Equal(res, tc.err) {
+			t.Errorf("Equal(DecodeJSON(%v), %v) is false, want true: %v", got, tc.err, Diff(res, tc.err))
+		}
+		data, err := EncodeGob(tc.err)
+		if err != nil {
+			t.Errorf("EncodeGob(%v) is \"%v\", want <nil>", tc.err, err)
+			continue
+		}
+		res, err = DecodeGob(data)
+		if err != nil {
+			t.Errorf("DecodeGob(%v) is \"%v\", want <nil>", tc.err, err)
+			continue
+		}
+		if s := EncodeJSON(res); s != got {
+			t.Errorf("EncodeJSON(DecodeGob(%v)) is %v, want %v", tc.err, s, got)
+		}
+	}
+
+	RegisterEncoder[*encoderTestError](nil)
+	if got := EncodeJSON(&encoderTestError{ID: "1"}); strings.Contains(got, `"Fields"`) {
+		t.Errorf("EncodeJSON() after unregistering is %v, want no fields", got)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,235 @@
+package errs
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxDiffs is maximum number of divergences reported by Diff function.
+const maxDiffs = 10
+
+// CompareOption type is self-referential function type for Equal and Diff functions. (functional options pattern)
+type CompareOption func(*comparer)
+
+// IgnoreContextKeys function returns CompareOption function value.
+// Context values of these keys are ignored in comparison (e.g. "function").
+func IgnoreContextKeys(keys ...string) CompareOption {
+	return func(c *comparer) {
+		for _, k := range keys {
+			c.ignore[k] = true
+		}
+	}
+}
+
+// comparer is working area for Equal and Diff functions.
+type comparer struct {
+	ignore map[string]bool
+	limit  int
+	diffs  []string
+	more   bool
+}
+
+func newComparer(limit int, opts []CompareOption) *comparer {
+	c := &comparer{ignore: map[string]bool{}, limit: limit}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Equal function reports whether two error trees are structurally the same.
+// It compares types, messages, context data, extra fields (see ExtraFields function), Err/Cause fields of *Error and children of *Errors (or other multi-errors).
+// Context values are compared in JSON representation, and *RemoteError is compared with its original type name,
+// so an error tree is equal to itself after JSON round-trip.
+func Equal(a, b error, opts ...CompareOption) bool {
+	c := newComparer(1, opts)
+	c.compare("<root>", a, b)
+	return len(c.diffs) == 0
+}
+
+// Diff function returns readable report of the first divergences between two error trees.
+// It returns empty string if two error trees are equal (see Equal function).
+func Diff(a, b error, opts ...CompareOption) string {
+	c := newComparer(maxDiffs, opts)
+	c.compare("<root>", a, b)
+	if len(c.diffs) == 0 {
+		return ""
+	}
+	if c.more {
+		c.diffs = append(c.diffs, "...")
+	}
+	return strings.Join(c.diffs, "\n")
+}
+
+func (c *comparer) report(path, format string, args ...interface{}) {
+	if len(c.diffs) >= c.limit {
+		c.more = true
+		return
+	}
+	c.diffs = append(c.diffs, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (c *comparer) compare(path string, a, b error) {
+	if len(c.diffs) >= c.limit {
+		c.more = true
+		return
+	}
+	if a == nil || b == nil {
+		if a != nil || b != nil {
+			c.report(path, "%s != %s", describe(a), describe(b))
+		}
+		return
+	}
+	if ta, tb := TypeName(a), TypeName(b); ta != tb {
+		c.report(path+".Type", "%q != %q", ta, tb)
+		return
+	}
+	if isStructural(a) != isStructural(b) || isStructural(a) && reflect.TypeOf(a) != reflect.TypeOf(b) {
+		// e.g. *RemoteError named "*errs.Error", or error type named "errs.Error" in other package
+		c.report(path+".Type", "%q != %q", qualifiedTypeName(reflect.TypeOf(a)), qualifiedTypeName(reflect.TypeOf(b)))
+		return
+	}
+	switch ea := a.(type) {
+	case *Error:
+		eb, _ := b.(*Error)
+		if ea == nil || eb == nil {
+			if ea != eb {
+				c.report(path, "%s != %s", describe(a), describe(b))
+			}
+			return
+		}
+		n := len(c.diffs)
+		if ea.public != eb.public {
+			c.report(path+".Public", "%q != %q", ea.public, eb.public)
+		}
+		c.compareContext(path+".Context", ea.Context, eb.Context)
+		c.compare(path+".Err", ea.Err, eb.Err)
+		c.compare(path+".Cause", ea.Cause, eb.Cause)
+		if len(c.diffs) == n { // e.g. message formatted by Errorf function or not
+			if ma, mb := ea.Error(), eb.Error(); ma != mb {
+				c.report(path+".Msg", "%q != %q", ma, mb)
+			}
+		}
+	case *Errors:
+		eb, _ := b.(*Errors)
+		if ea == nil || eb == nil {
+			if ea != eb {
+				c.report(path, "%s != %s", describe(a), describe(b))
+			}
+			return
+		}
+		if da, db := ea.Dropped(), eb.Dropped(); da != db {
+			c.report(path+".Dropped", "%d != %d", da, db)
+		}
+		if ca, cb := fmt.Sprint(ea.OccurrenceCounts()), fmt.Sprint(eb.OccurrenceCounts()); ca != cb {
+			c.report(path+".Occurrences", "%s != %s", ca, cb)
+		}
+		c.compareList(path+".Errs", ea.Unwrap(), eb.Unwrap())
+	default:
+		if ma, mb := a.Error(), b.Error(); ma != mb {
+			c.report(path+".Msg", "%q != %q", ma, mb)
+		}
+		c.compareContext(path+".Fields", ExtraFields(a), ExtraFields(b))
+		la, lb := Unwraps(a), Unwraps(b)
+		if len(la) <= 1 && len(lb) <= 1 {
+			c.compare(path+".Cause", first(la), first(lb))
+			return
+		}
+		c.compareList(path+".Cause", la, lb)
+	}
+}
+
+func (c *comparer) compareList(path string, la, lb []error) {
+	if len(la) != len(lb) {
+		c.report(path, "%d errors != %d errors", len(la), len(lb))
+	}
+	for i := 0; i < len(la) && i < len(lb); i++ {
+		c.compare(fmt.Sprintf("%s[%d]", path, i), la[i], lb[i])
+	}
+}
+
+func (c *comparer) compareContext(path string, ca, cb map[string]interface{}) {
+	keys := map[string]bool{}
+	for k := range ca {
+		keys[k] = true
+	}
+	for k := range cb {
+		keys[k] = true
+	}
+	list := make([]string, 0, len(keys))
+	for k := range keys {
+		if !c.ignore[k] {
+			list = append(list, k)
+		}
+	}
+	sort.Strings(list)
+	for _, k := range list {
+		va, oka := ca[k]
+		vb, okb := cb[k]
+		switch {
+		case !oka:
+			c.report(path+"."+k, "<none> != %s", contextValueString(vb))
+		case !okb:
+			c.report(path+"."+k, "%s != <none>", contextValueString(va))
+		default:
+			if sa, sb := contextValueString(va), contextValueString(vb); sa != sb {
+				c.report(path+"."+k, "%s != %s", sa, sb)
+			}
+		}
+	}
+}
+
+// describe returns short description of error instance.
+func describe(err error) string {
+	if err == nil {
+		return nilAngleString
+	}
+	return fmt.Sprintf("%s(%q)", TypeName(err), err.Error())
+}
+
+// isStructural reports whether err is *Error or *Errors instance (compared field by field).
+func isStructural(err error) bool {
+	switch err.(type) {
+	case *Error, *Errors:
+		return true
+	}
+	return false
+}
+
+// qualifiedTypeName returns type name with package path (e.g. "*github.com/goark/errs.Error").
+func qualifiedTypeName(t reflect.Type) string {
+	prefix := ""
+	for t.Kind() == reflect.Ptr && len(t.Name()) == 0 {
+		prefix += "*"
+		t = t.Elem()
+	}
+	if len(t.PkgPath()) == 0 {
+		return prefix + t.String()
+	}
+	return prefix + t.PkgPath() + "." + t.Name()
+}
+
+// first returns the first error in the list.
+func first(list []error) error {
+	if len(list) == 0 {
+		return nil
+	}
+	return list[0]
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,157 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	fakeerrs "github.com/goark/errs/internal/fakeerrs"
+)
+
+func TestEqual(t *testing.T) {
+	testCases := []struct {
+		a, b  error
+		opts  []CompareOption
+		equal bool
+		diff  string
+	}{
+		{a: nil, b: nil, equal: true},
+		{a: nil, b: io.EOF, equal: false, diff: `<root>: <nil> != *errors.errorString("EOF")`},
+		{a: io.EOF, b: errors.New("EOF"), equal: true},
+		{a: io.EOF, b: os.ErrInvalid, equal: false, diff: `<root>.Msg: "EOF" != "invalid argument"`},
+		{a: io.EOF, b: Wrap(io.EOF), equal: false, diff: `<root>.Type: "*errors.errorString" != "*errs.Error"`},
+		{
+			a:     New("error", WithContext("num", 1), WithContext("id", "a")),
+			b:     New("error", WithContext("num", 2), WithContext("id", "b")),
+			opts:  []CompareOption{IgnoreContextKeys("id")},
+			equal: false,
+			diff:  `<root>.Context.num: 1 != 2`,
+		},
+		{
+			a:     New("error", WithContext("id", "a")),
+			b:     New("error", WithContext("id", "b")),
+			opts:  []CompareOption{IgnoreContextKeys("id")},
+			equal: true,
+		},
+		{
+			a:     New("error", WithCause(io.EOF), WithContext("foo", "bar")),
+			b:     Wrap(errors.New("error"), WithCause(io.ErrUnexpectedEOF)),
+			equal: false,
+			diff:  "<root>.Context.foo: \"bar\" != <none>\n<root>.Cause.Msg: \"EOF\" != \"unexpected EOF\"",
+		},
+		{
+			a:     &RemoteError{Type: "*errs.Error", Msg: "error"},
+			b:     New("error"),
+			equal: false,
+			diff:  `<root>.Type: "*github.com/goark/errs.RemoteError" != "*github.com/goark/errs.Error"`,
+		},
+		{
+			a:     Wrap(io.EOF),
+			b:     &fakeerrs.Error{Msg: "EOF"},
+			equal: false,
+			diff:  `<root>.Type: "*github.com/goark/errs.Error" != "*github.com/goark/errs/internal/fakeerrs.Error"`,
+		},
+		{a: (*Error)(nil), b: (*Error)(nil), equal: true},
+		{a: (*Error)(nil), b: New("error"), equal: false, diff: `<root>: *errs.Error("<nil>") != *errs.Error("error")`},
+		{a: Join(io.EOF), b: (*Errors)(nil), equal: false, diff: `<root>: *errs.Errors("EOF") != *errs.Errors("<nil>")`},
+		{
+			a:     Errorf("read: %w", io.EOF),
+			b:     New("read: EOF", WithCause(io.EOF)),
+			equal: false,
+			diff:  `<root>.Msg: "read: EOF" != "read: EOF: EOF"`,
+		},
+		{
+			a:     Join(io.EOF, os.ErrInvalid),
+			b:     Join(io.EOF),
+			equal: false,
+			diff:  "<root>.Errs: 2 errors != 1 errors",
+		},
+		{
+			a:     errors.Join(io.EOF, os.ErrInvalid),
+			b:     errors.Join(io.EOF, os.ErrNotExist),
+			equal: false,
+			diff:  "<root>.Msg: \"EOF\\ninvalid argument\" != \"EOF\\nfile does not exist\"\n<root>.Cause[1].Msg: \"invalid argument\" != \"file does not exist\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := Equal(tc.a, tc.b, tc.opts...); got != tc.equal {
+			t.Errorf("Equal(%v, %v) is %v, want %v", tc.a, tc.b, got, tc.equal)
+		}
+		if got := Diff(tc.a, tc.b, tc.opts...); got != tc.diff {
+			t.Errorf("Diff(%v, %v) is\n%v\nwant\n%v", tc.a, tc.b, got, tc.diff)
+		}
+	}
+}
+
+func TestEqualRoundTrip(t *testing.T) {
+	testCases := []struct {
+		err error
+	}{
+		{err: wrapedErrTest2},
+		{err: New("error", WithCause(Join(io.EOF, Wrap(os.ErrInvalid))), WithContext("num", 1), WithContext("list", []int{1, 2}))},
+		{err: Wrap(os.ErrInvalid, WithCause(errors.Join(io.EOF, io.ErrUnexpectedEOF)))},
+		{err: &os.PathError{Op: "open", Path: "a.txt", Err: os.ErrNotExist}},
+		{err: Errorf("read config: %w", io.EOF)},
+		{err: Wrap(Errorf("read config: %w", io.EOF))},
+		{err: Wrap(os.ErrInvalid, WithPublicMessage("bad <request>"))},
+	}
+
+	for _, tc := range testCases {
+		err, e := DecodeJSON([]byte(EncodeJSON(tc.err)))
+		if e != nil {
+			t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", tc.err, e)
+			continue
+		}
+		if got, want := err.Error(), tc.err.Error(); got != want {
+			t.Errorf("Error() after JSON round-trip is %v, want %v", got, want)
+		}
+		if !Equal(tc.err, err) {
+			t.Errorf("Equal(%v) after JSON round-trip is false, want true:\n%v", tc.err, Diff(tc.err, err))
+		}
+	}
+}
+
+func TestDiffLimit(t *testing.T) {
+	a, b := &Errors{}, &Errors{}
+	for i := 0; i < maxDiffs+5; i++ {
+		a.Add(io.EOF)
+		b.Add(os.ErrInvalid)
+	}
+	diff := Diff(a, b)
+	if got := len(splitLines(diff)); got != maxDiffs+1 {
+		t.Errorf("lines of Diff() is %v, want %v:\n%v", got, maxDiffs+1, diff)
+	}
+}
+
+func splitLines(s string) []string {
+	var lines []string
+	for len(s) > 0 {
+		i := 0
+		for i < len(s) && s[i] != '\n' {
+			i++
+		}
+		lines = append(lines, s[:i])
+		if i < len(s) {
+			i++
+		}
+		s = s[i:]
+	}
+	return lines
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -4,16 +4,89 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"reflect"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 // Errors is multiple error instance.
 type Errors struct {
-	mu   sync.RWMutex
-	errs []error
+	mu      sync.RWMutex
+	errs    []error
+	head    int          // start index of ring buffer (KeepLast policy)
+	dropped int          // number of dropped errors by capacity limit
+	limit   *errorsLimit // nil if unbounded
+	dedup   *errorsDedup // nil if dedup mode is off
+	flat    bool         // flatten nested multi-errors in Add method
+	occurs  []occurrence // occurrences of errors in the same order as errs (nil if not tracked)
+}
+
+// OverflowPolicy type is policy of Errors when the number of errors exceeds the capacity.
+type OverflowPolicy int
+
+const (
+	// KeepFirst policy keeps the first errors and drops new errors.
+	KeepFirst OverflowPolicy = iota
+	// KeepLast policy keeps the last errors (ring buffer) and drops old errors.
+	KeepLast
+	// Reservoir policy keeps uniform random sample of all errors (reservoir sampling).
+	Reservoir
+)
+
+// errorsLimit is capacity limit and threshold of Errors.
+type errorsLimit struct {
+	capacity  int
+	policy    OverflowPolicy
+	threshold int
+	seen      int
+	done      chan struct{}
+}
+
+// ErrorsOption type is self-referential function type for NewErrors function. (functional options pattern)
+type ErrorsOption func(*Errors)
+
+// WithCapacity function returns ErrorsOption function value.
+// This function sets maximum number of errors kept in Errors and the policy for overflow.
+// Dropped errors are counted (see Errors.Dropped method).
+func WithCapacity(capacity int, policy OverflowPolicy) ErrorsOption {
+	return func(es *Errors) {
+		if capacity > 0 {
+			es.getLimit().capacity = capacity
+			es.limit.policy = policy
+		}
+	}
+}
+
+// WithThreshold function returns ErrorsOption function value.
+// When the number of added errors (including dropped errors) reaches the threshold,
+// Errors.Full method returns true and the channel of Errors.Done method is closed.
+func WithThreshold(threshold int) ErrorsOption {
+	return func(es *Errors) {
+		if threshold > 0 {
+			es.getLimit().threshold = threshold
+			es.limit.done = make(chan struct{})
+		}
+	}
+}
+
+func (es *Errors) getLimit() *errorsLimit {
+	if es.limit == nil {
+		es.limit = &errorsLimit{}
+	}
+	return es.limit
+}
+
+// NewErrors function returns empty Errors instance with options (capacity limit and so on).
+// Zero value of Errors is unbounded.
+func NewErrors(opts ...ErrorsOption) *Errors {
+	es := &Errors{}
+	for _, opt := range opts {
+		opt(es)
+	}
+	return es
 }
 
 // Join function returns Errors instance.
@@ -36,21 +109,156 @@ func Join(errlist ...error) error {
 			es.errs = append(es.errs, err)
 		}
 	}
+	errorsHooks.call(es)
 	return es
 }
 
 // Add method adds errors to Errors.
+// Nested multi-errors are flattened if Errors is created with WithFlatten option.
 func (es *Errors) Add(errlist ...error) {
 	if es == nil {
 		return
 	}
+	if es.flat {
+		f := &flattener{}
+		for _, err := range errlist {
+			f.walk(err, occurrence{})
+		}
+		es.mu.Lock()
+		defer es.mu.Unlock()
+		es.dropped += f.dropped
+		if f.tracked && es.occurs == nil {
+			es.occurs = make([]occurrence, len(es.errs))
+			for i := range es.occurs {
+				es.occurs[i].count = 1
+			}
+		}
+		for i, err := range f.errs {
+			es.add(err, f.occurs[i])
+		}
+		return
+	}
 	es.mu.Lock()
 	defer es.mu.Unlock()
 	for _, err := range errlist {
 		if err != nil {
-			es.errs = append(es.errs, err)
+			es.add(err, occurrence{})
+		}
+	}
+}
+
+// add adds an error by the capacity limit (lock must be held).
+// occ is occurrence of nested *Errors instance (zero value for new error).
+func (es *Errors) add(err error, occ occurrence) {
+	if occ.count == 0 {
+		occ.count = 1
+	}
+	if occ.first.IsZero() {
+		now := time.Now()
+		occ.first, occ.last = now, now
+	}
+	if es.dedup != nil {
+		occ.key = es.dedup.fn(err)
+		if i, ok := es.dedup.index[occ.key]; ok {
+			es.countUp()
+			es.occurs[i].count += occ.count
+			if occ.last.After(es.occurs[i].last) {
+				es.occurs[i].last = occ.last
+			}
+			if !occ.first.IsZero() && occ.first.Before(es.occurs[i].first) {
+				es.occurs[i].first = occ.first
+			}
+			return
+		}
+	}
+	es.countUp()
+	if l := es.limit; l == nil || l.capacity <= 0 || len(es.errs) < l.capacity {
+		es.errs = append(es.errs, err)
+		es.track(len(es.errs)-1, occ)
+		return
+	}
+	switch es.limit.policy {
+	case KeepLast:
+		es.replace(es.head, err, occ)
+		es.head = (es.head + 1) % len(es.errs)
+	case Reservoir:
+		if i := rand.Intn(es.limit.seen); i < len(es.errs) { // #nosec G404 -- sampling is not security sensitive
+			es.replace(i, err, occ)
+		} else {
+			es.dropped += occ.count
+		}
+	default:
+		es.dropped += occ.count
+	}
+}
+
+// countUp counts an added error for the threshold (lock must be held).
+func (es *Errors) countUp() {
+	if l := es.limit; l != nil {
+		l.seen++
+		if l.threshold > 0 && l.seen == l.threshold {
+			close(l.done)
+		}
+	}
+}
+
+// replace replaces i-th error and counts the dropped occurrences (lock must be held).
+func (es *Errors) replace(i int, err error, occ occurrence) {
+	if es.occurs != nil {
+		es.dropped += es.occurs[i].count
+		if es.dedup != nil {
+			delete(es.dedup.index, es.occurs[i].key)
 		}
+	} else {
+		es.dropped++
+	}
+	es.errs[i] = err
+	es.track(i, occ)
+}
+
+// list returns errors in order (lock must be held).
+func (es *Errors) list() []error {
+	if es.head == 0 {
+		return es.errs
+	}
+	list := make([]error, 0, len(es.errs))
+	list = append(list, es.errs[es.head:]...)
+	return append(list, es.errs[:es.head]...)
+}
+
+// Dropped method returns number of errors dropped by capacity limit (see WithCapacity function).
+func (es *Errors) Dropped() int {
+	if es == nil {
+		return 0
+	}
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+	return es.dropped
+}
+
+// Full method reports whether the number of added errors reaches the threshold (see WithThreshold function).
+// Producers can stop early if it returns true.
+func (es *Errors) Full() bool {
+	if es == nil {
+		return false
 	}
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+	return es.limit != nil && es.limit.threshold > 0 && es.limit.seen >= es.limit.threshold
+}
+
+// Done method returns a channel that is closed when the number of added errors reaches the threshold
+// (see WithThreshold function). If Errors has no threshold, it returns nil channel (never closed).
+func (es *Errors) Done() <-chan struct{} {
+	if es == nil {
+		return nil
+	}
+	es.mu.RLock()
+	defer es.mu.RUnlock()
+	if es.limit == nil {
+		return nil
+	}
+	return es.limit.done
 }
 
 // ErrorOrNil method returns this as a error type.
@@ -78,11 +286,22 @@ func (es *Errors) Error() string {
 		return nilAngleString
 	}
 	var b []byte
-	for i, err := range es.errs {
+	occurs := es.listOccurs()
+	for i, err := range es.list() {
 		if i > 0 {
 			b = append(b, '\n')
 		}
 		b = append(b, err.Error()...)
+		if i < len(occurs) && occurs[i].count > 1 {
+			b = append(b, " ("...)
+			b = strconv.AppendInt(b, int64(occurs[i].count), 10)
+			b = append(b, " times)"...)
+		}
+	}
+	if es.dropped > 0 {
+		b = append(b, "\nand "...)
+		b = strconv.AppendInt(b, int64(es.dropped), 10)
+		b = append(b, " more"...)
 	}
 	return string(b)
 }
@@ -104,7 +323,10 @@ func (es *Errors) GoString() string {
 	if len(es.errs) == 0 {
 		return nilAngleString
 	}
-	return fmt.Sprintf("%T{Errs:%#v}", es, es.errs)
+	if es.dropped > 0 {
+		return fmt.Sprintf("%T{Errs:%#v, Dropped:%d}", es, es.list(), es.dropped)
+	}
+	return fmt.Sprintf("%T{Errs:%#v}", es, es.list())
 }
 
 // MarshalJSON method returns serialize string of Errors with JSON format.
@@ -144,13 +366,23 @@ func (es *Errors) EncodeJSON() string {
 	elms = append(elms, strings.Join([]string{`"Type":`, strconv.Quote(reflect.TypeOf(es).String())}, ""))
 	if len(es.errs) > 0 {
 		elms2 := []string{}
-		for _, err := range es.errs {
+		for _, err := range es.list() {
 			msgBuf := &bytes.Buffer{}
 			json.HTMLEscape(msgBuf, []byte(EncodeJSON(err)))
 			elms2 = append(elms2, msgBuf.String())
 		}
 		elms = append(elms, strings.Join([]string{`"Errs":[`, strings.Join(elms2, ","), "]"}, ""))
 	}
+	if occurs := es.listOccurs(); len(occurs) > 0 {
+		elms2 := make([]string, 0, len(occurs))
+		for _, occ := range occurs {
+			elms2 = append(elms2, occ.encodeJSON())
+		}
+		elms = append(elms, strings.Join([]string{`"Occurrences":[`, strings.Join(elms2, ","), "]"}, ""))
+	}
+	if es.dropped > 0 {
+		elms = append(elms, strings.Join([]string{`"Dropped":`, strconv.Itoa(es.dropped)}, ""))
+	}
 	return strings.Join([]string{"{", strings.Join(elms, ","), "}"}, "")
 }
 
@@ -165,8 +397,9 @@ func (es *Errors) Unwrap() []error {
 	if len(es.errs) == 0 {
 		return nil
 	}
-	cpy := make([]error, len(es.errs), cap(es.errs))
-	copy(cpy, es.errs)
+	list := es.list()
+	cpy := make([]error, len(list), cap(list))
+	copy(cpy, list)
 	return cpy
 }
 
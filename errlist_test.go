@@ -0,0 +1,131 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func addNumbered(es *Errors, n int) {
+	for i := 1; i <= n; i++ {
+		es.Add(fmt.Errorf("error %d", i))
+	}
+}
+
+func TestNewErrorsCapacity(t *testing.T) {
+	testCases := []struct {
+		opts    []ErrorsOption
+		n       int
+		msg     string
+		json    string
+		dropped int
+	}{
+		{opts: nil, n: 3, msg: "error 1\nerror 2\nerror 3", json: `{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"error 1"},{"Type":"*errors.errorString","Msg":"error 2"},{"Type":"*errors.errorString","Msg":"error 3"}]}`, dropped: 0},
+		{opts: []ErrorsOption{WithCapacity(2, KeepFirst)}, n: 1, msg: "error 1", json: `{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"error 1"}]}`, dropped: 0},
+		{opts: []ErrorsOption{WithCapacity(2, KeepFirst)}, n: 5, msg: "error 1\nerror 2\nand 3 more", json: `{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"error 1"},{"Type":"*errors.errorString","Msg":"error 2"}],"Dropped":3}`, dropped: 3},
+		{opts: []ErrorsOption{WithCapacity(2, KeepLast)}, n: 5, msg: "error 4\nerror 5\nand 3 more", json: `{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"error 4"},{"Type":"*errors.errorString","Msg":"error 5"}],"Dropped":3}`, dropped: 3},
+		{opts: []ErrorsOption{WithCapacity(3, KeepLast)}, n: 4, msg: "error 2\nerror 3\nerror 4\nand 1 more", json: `{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"error 2"},{"Type":"*errors.errorString","Msg":"error 3"},{"Type":"*errors.errorString","Msg":"error 4"}],"Dropped":1}`, dropped: 1},
+		{opts: []ErrorsOption{WithCapacity(0, KeepLast)}, n: 2, msg: "error 1\nerror 2", json: `{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"error 1"},{"Type":"*errors.errorString","Msg":"error 2"}]}`, dropped: 0},
+	}
+
+	for _, tc := range testCases {
+		es := NewErrors(tc.opts...)
+		addNumbered(es, tc.n)
+		if got := es.Error(); got != tc.msg {
+			t.Errorf("Errors.Error() is %q, want %q", got, tc.msg)
+		}
+		if got := es.EncodeJSON(); got != tc.json {
+			t.Errorf("Errors.EncodeJSON() is %v, want %v", got, tc.json)
+		}
+		if got := es.Dropped(); got != tc.dropped {
+			t.Errorf("Errors.Dropped() is %v, want %v", got, tc.dropped)
+		}
+		res, err := DecodeJSON([]byte(tc.json))
+		if err != nil {
+			t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", tc.json, err)
+			continue
+		}
+		if got := res.Error(); got != tc.msg {
+			t.Errorf("DecodeJSON(%v).Error() is %q, want %q", tc.json, got, tc.msg)
+		}
+		if !Equal(res, es) {
+			t.Errorf("Equal(DecodeJSON(%v), Errors) is false, want true: %v", tc.json, Diff(res, es))
+		}
+		data, err := EncodeGob(es)
+		if err != nil {
+			t.Errorf("EncodeGob(%v) is \"%v\", want <nil>", es, err)
+			continue
+		}
+		if res, err = DecodeGob(data); err != nil {
+			t.Errorf("DecodeGob(%v) is \"%v\", want <nil>", es, err)
+			continue
+		}
+		if got := EncodeJSON(res); got != tc.json {
+			t.Errorf("EncodeJSON(DecodeGob(%v)) is %v, want %v", es, got, tc.json)
+		}
+	}
+}
+
+func TestNewErrorsReservoir(t *testing.T) {
+	es := NewErrors(WithCapacity(10, Reservoir))
+	addNumbered(es, 1000)
+	if got := len(es.Unwrap()); got != 10 {
+		t.Errorf("len(Errors.Unwrap()) is %v, want %v", got, 10)
+	}
+	if got := es.Dropped(); got != 990 {
+		t.Errorf("Errors.Dropped() is %v, want %v", got, 990)
+	}
+	seen := map[string]bool{}
+	for _, err := range es.Unwrap() {
+		if seen[err.Error()] {
+			t.Errorf("%v is duplicated in reservoir", err)
+		}
+		seen[err.Error()] = true
+	}
+}
+
+func TestNewErrorsThreshold(t *testing.T) {
+	es := NewErrors(WithCapacity(1, KeepFirst), WithThreshold(3))
+	done := es.Done()
+	for i := 1; i <= 5; i++ {
+		es.Add(io.EOF)
+		full := i >= 3
+		if got := es.Full(); got != full {
+			t.Errorf("Errors.Full() after %d errors is %v, want %v", i, got, full)
+		}
+		select {
+		case <-done:
+			if !full {
+				t.Errorf("Errors.Done() is closed after %d errors, want open", i)
+			}
+		default:
+			if full {
+				t.Errorf("Errors.Done() is open after %d errors, want closed", i)
+			}
+		}
+	}
+	if !errors.Is(es, io.EOF) {
+		t.Errorf("errors.Is(%v, io.EOF) is false, want true", es)
+	}
+	unbounded := &Errors{}
+	unbounded.Add(io.EOF)
+	if unbounded.Full() || unbounded.Done() != nil {
+		t.Error("unbounded Errors is full, want not full")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,55 @@
+//go:build !plan9
+
+package errs
+
+import "syscall"
+
+// errnoNames is symbolic names of common syscall.Errno values.
+var errnoNames = map[syscall.Errno]string{
+	syscall.EPERM:        "EPERM",
+	syscall.ENOENT:       "ENOENT",
+	syscall.EINTR:        "EINTR",
+	syscall.EIO:          "EIO",
+	syscall.EBADF:        "EBADF",
+	syscall.EAGAIN:       "EAGAIN",
+	syscall.ENOMEM:       "ENOMEM",
+	syscall.EACCES:       "EACCES",
+	syscall.EEXIST:       "EEXIST",
+	syscall.ENOTDIR:      "ENOTDIR",
+	syscall.EISDIR:       "EISDIR",
+	syscall.EINVAL:       "EINVAL",
+	syscall.EMFILE:       "EMFILE",
+	syscall.ENOSPC:       "ENOSPC",
+	syscall.EPIPE:        "EPIPE",
+	syscall.ENOTEMPTY:    "ENOTEMPTY",
+	syscall.EADDRINUSE:   "EADDRINUSE",
+	syscall.ECONNREFUSED: "ECONNREFUSED",
+	syscall.ECONNRESET:   "ECONNRESET",
+	syscall.ETIMEDOUT:    "ETIMEDOUT",
+}
+
+func init() {
+	RegisterGobType(syscall.Errno(0))
+	RegisterEncoder(func(e syscall.Errno) map[string]interface{} {
+		fields := map[string]interface{}{"Errno": uint64(e)}
+		if name, ok := errnoNames[e]; ok {
+			fields["Name"] = name
+		}
+		return fields
+	})
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
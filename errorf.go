@@ -0,0 +1,81 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errorf function returns an error instance formatted according to a format specifier (see fmt.Errorf function).
+// Every operand of %w verb becomes the cause (multiple operands are joined by Join function),
+// and the message of error instance is the formatted string.
+// Like fmt.Errorf function, it returns an error instance even if the message is empty.
+func Errorf(format string, a ...interface{}) error {
+	return errorf(fmt.Errorf(format, a...), format, a, false, nil)
+}
+
+// ErrorfWith function returns a function same as Errorf function that applies ErrorContextFunc options to the error instance.
+// If formatArgs is true, the format (message template) and arguments are captured
+// as context data: "format", "arg0", "arg1", ... (error arguments are captured as their messages).
+// The cause by WithCause option is joined with operands of %w verb.
+//
+//	err := errs.ErrorfWith(true, errs.WithContext("foo", "bar"))("read %s: %w", path, err)
+func ErrorfWith(formatArgs bool, opts ...ErrorContextFunc) func(format string, a ...interface{}) error {
+	return func(format string, a ...interface{}) error {
+		return errorf(fmt.Errorf(format, a...), format, a, formatArgs, opts)
+	}
+}
+
+// errorf function returns *Error instance from ferr (formatted by fmt.Errorf function in caller).
+// fmt.Errorf function is called directly in Errorf function, so go vet command checks its format string.
+func errorf(ferr error, format string, a []interface{}, formatArgs bool, opts []ErrorContextFunc) error {
+	causes := []error{}
+	for _, c := range Unwraps(ferr) {
+		if c != nil {
+			causes = append(causes, c)
+		}
+	}
+	init := func(e *Error) {
+		e.msgFlag = true
+		if !formatArgs {
+			return
+		}
+		_ = e.SetContext("format", format)
+		for i, arg := range a {
+			if err, ok := arg.(error); ok {
+				arg = err.Error()
+			}
+			_ = e.SetContext(fmt.Sprintf("arg%d", i), arg)
+		}
+	}
+	setCauses := func(e *Error) {
+		if e.Cause != nil {
+			causes = append(causes, e.Cause)
+		}
+		switch len(causes) {
+		case 0:
+		case 1:
+			e.Cause = causes[0]
+		default:
+			e.Cause = Join(causes...)
+		}
+	}
+	list := make([]ErrorContextFunc, 0, len(opts)+2)
+	list = append(list, init)
+	list = append(list, opts...)
+	return newError(errors.New(ferr.Error()), false, 3, append(list, setCauses)...)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,92 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestErrorf(t *testing.T) {
+	testCases := []struct {
+		err  error
+		msg  string
+		json string
+	}{
+		{
+			err:  Errorf(""),
+			msg:  "",
+			json: `{"Type":"*errs.Error","Msg":"","Err":{"Type":"*errors.errorString","Msg":""},"Context":{"function":"github.com/goark/errs.TestErrorf"}}`,
+		},
+		{
+			err:  Errorf("read %s", "config.json"),
+			msg:  "read config.json",
+			json: `{"Type":"*errs.Error","Msg":"read config.json","Err":{"Type":"*errors.errorString","Msg":"read config.json"},"Context":{"function":"github.com/goark/errs.TestErrorf"}}`,
+		},
+		{
+			err:  Errorf("read %s: %w", "config.json", io.EOF),
+			msg:  "read config.json: EOF",
+			json: `{"Type":"*errs.Error","Msg":"read config.json: EOF","Err":{"Type":"*errors.errorString","Msg":"read config.json: EOF"},"Context":{"function":"github.com/goark/errs.TestErrorf"},"Cause":{"Type":"*errors.errorString","Msg":"EOF"}}`,
+		},
+		{
+			err:  Errorf("%w and %w", io.EOF, os.ErrInvalid),
+			msg:  "EOF and invalid argument",
+			json: `{"Type":"*errs.Error","Msg":"EOF and invalid argument","Err":{"Type":"*errors.errorString","Msg":"EOF and invalid argument"},"Context":{"function":"github.com/goark/errs.TestErrorf"},"Cause":{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"EOF"},{"Type":"*errors.errorString","Msg":"invalid argument"}]}}`,
+		},
+		{
+			err:  ErrorfWith(true, WithContext("foo", "bar"))("read %s: %w", "config.json", io.EOF),
+			msg:  "read config.json: EOF",
+			json: `{"Type":"*errs.Error","Msg":"read config.json: EOF","Err":{"Type":"*errors.errorString","Msg":"read config.json: EOF"},"Context":{"arg0":"config.json","arg1":"EOF","foo":"bar","format":"read %s: %w","function":"github.com/goark/errs.TestErrorf"},"Cause":{"Type":"*errors.errorString","Msg":"EOF"}}`,
+		},
+		{
+			err:  ErrorfWith(false, WithCause(os.ErrInvalid))("read: %w", io.EOF),
+			msg:  "read: EOF",
+			json: `{"Type":"*errs.Error","Msg":"read: EOF","Err":{"Type":"*errors.errorString","Msg":"read: EOF"},"Context":{"function":"github.com/goark/errs.TestErrorf"},"Cause":{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"EOF"},{"Type":"*errors.errorString","Msg":"invalid argument"}]}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := EncodeJSON(tc.err); got != tc.json {
+			t.Errorf("EncodeJSON(%v) is %v, want %v", tc.err, got, tc.json)
+		}
+		if tc.err == nil {
+			continue
+		}
+		if got := tc.err.Error(); got != tc.msg {
+			t.Errorf("Error() is %v, want %v", got, tc.msg)
+		}
+	}
+}
+
+func TestErrorfIs(t *testing.T) {
+	err := Errorf("%w and %w", io.EOF, os.ErrInvalid)
+	for _, target := range []error{io.EOF, os.ErrInvalid} {
+		if !errors.Is(err, target) {
+			t.Errorf("errors.Is(%v, %v) is false, want true", err, target)
+		}
+	}
+	withCause := ErrorfWith(false, WithCause(os.ErrInvalid))("read: %w", io.EOF)
+	for _, target := range []error{io.EOF, os.ErrInvalid} {
+		if !errors.Is(withCause, target) {
+			t.Errorf("errors.Is(%v, %v) is false, want true", withCause, target)
+		}
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("errors.Is(%v, %v) is true, want false", err, io.ErrUnexpectedEOF)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -20,6 +20,11 @@ const (
 // This type is for wrapping cause error instance.
 type Error struct {
 	wrapFlag bool
+	msgFlag  bool   // Err message already contains messages of causes (Errorf function)
+	public   string // message safe to show API users (see WithPublicMessage function)
+	depth    int    // call depth of caller while options are applied in newError function (0 out of newError function)
+	skip     int    // frames skipped by WithCallerSkip function (used in newError function only)
+	stack    bool   // stack trace is requested by WithStack function (used in newError function only)
 	Err      error
 	Cause    error
 	Context  map[string]interface{}
@@ -52,7 +57,7 @@ func Wrap(err error, opts ...ErrorContextFunc) error {
 
 // newError returns error instance. (internal)
 func newError(err error, wrapFlag bool, depth int, opts ...ErrorContextFunc) error {
-	we := &Error{Err: err, wrapFlag: wrapFlag}
+	we := &Error{Err: err, wrapFlag: wrapFlag, depth: depth + 1}
 	//caller function name
 	if fname, _, _ := caller(depth); len(fname) > 0 {
 		we = we.SetContext("function", fname)
@@ -61,6 +66,17 @@ func newError(err error, wrapFlag bool, depth int, opts ...ErrorContextFunc) err
 	for _, opt := range opts {
 		opt(we)
 	}
+	//caller function name and stack trace of helper function's caller (WithCallerSkip and WithStack functions)
+	if we.skip > 0 {
+		if fname, _, _ := caller(depth + we.skip); len(fname) > 0 {
+			we = we.SetContext("function", fname)
+		}
+	}
+	if we.stack {
+		we = we.SetContext("stack", callers(depth+we.skip, false))
+	}
+	we.depth, we.skip, we.stack = 0, 0, false
+	errorHooks.call(we)
 	return we
 }
 
@@ -142,6 +158,9 @@ func (e *Error) Error() string {
 		return nilAngleString
 	}
 	errMsg := e.Err.Error()
+	if e.msgFlag {
+		return errMsg
+	}
 	var causeMsg string
 	if e.Cause != nil {
 		causeMsg = e.Cause.Error()
@@ -177,6 +196,7 @@ func (e *Error) MarshalJSON() ([]byte, error) {
 }
 
 // EncodeJSON method returns serialize string of Error with JSON format.
+// "Msg" (error message) is output only if the message is not composed of Err and Cause (e.g. Errorf function).
 func (e *Error) EncodeJSON() string {
 	if e == nil {
 		return "null"
@@ -184,6 +204,11 @@ func (e *Error) EncodeJSON() string {
 	elms := []string{}
 	elms = append(elms, strings.Join([]string{`"Type":`, strconv.Quote(reflect.TypeOf(e).String())}, ""))
 	msgBuf := &bytes.Buffer{}
+	if e.msgFlag {
+		json.HTMLEscape(msgBuf, bytes.Join([][]byte{[]byte(`"Msg":`), []byte(strconv.Quote(e.Error()))}, []byte{}))
+		elms = append(elms, msgBuf.String())
+		msgBuf.Reset()
+	}
 	json.HTMLEscape(msgBuf, bytes.Join([][]byte{[]byte(`"Err":`), []byte(EncodeJSON(e.Err))}, []byte{}))
 	elms = append(elms, msgBuf.String())
 	if len(e.Context) > 0 {
@@ -191,6 +216,11 @@ func (e *Error) EncodeJSON() string {
 			elms = append(elms, string(bytes.Join([][]byte{[]byte(`"Context":`), b}, []byte{})))
 		}
 	}
+	if len(e.public) > 0 {
+		pubBuf := &bytes.Buffer{}
+		json.HTMLEscape(pubBuf, bytes.Join([][]byte{[]byte(`"Public":`), []byte(strconv.Quote(e.public))}, []byte{}))
+		elms = append(elms, pubBuf.String())
+	}
 	if e.Cause != nil && !reflect.ValueOf(e.Cause).IsZero() {
 		elms = append(elms, strings.Join([]string{`"Cause":`, EncodeJSON(e.Cause)}, ""))
 	}
@@ -232,21 +262,39 @@ func Cause(err error) error {
 }
 
 // Unwraps function finds cause errors ([]error slice) in target error instance.
+// It recognizes Unwrap() []error method, and Errors() []error and WrappedErrors() []error methods
+// (go.uber.org/multierr and github.com/hashicorp/go-multierror packages) too.
+// Cause() error method (github.com/pkg/errors package) is followed if the error has no Unwrap method.
 func Unwraps(err error) []error {
 	if err == nil {
 		return nil
 	}
-	if es, ok := err.(interface {
-		Unwrap() []error
-	}); ok {
-		return es.Unwrap()
+	if errlist, ok := unwrapMulti(err); ok {
+		return errlist
 	}
 	if e := errors.Unwrap(err); e != nil {
 		return []error{e}
 	}
+	if e := unwrapCause(err); e != nil {
+		return []error{e}
+	}
 	return nil
 }
 
+// unwrapMulti returns cause errors of multi-error
+// (Unwrap() []error, Errors() []error or WrappedErrors() []error method).
+func unwrapMulti(err error) ([]error, bool) {
+	switch x := err.(type) {
+	case interface{ Unwrap() []error }:
+		return x.Unwrap(), true
+	case interface{ Errors() []error }:
+		return x.Errors(), true
+	case interface{ WrappedErrors() []error }:
+		return x.WrappedErrors(), true
+	}
+	return nil, false
+}
+
 // caller returns caller info.
 func caller(depth int) (string, string, int) {
 	pc, src, line, ok := runtime.Caller(depth + 1)
@@ -280,14 +328,10 @@ func encodeJSON(err error) string {
 	msgBuf := &bytes.Buffer{}
 	json.HTMLEscape(msgBuf, bytes.Join([][]byte{[]byte(`"Msg":`), []byte(strconv.Quote(err.Error()))}, []byte{}))
 	elms = append(elms, msgBuf.String())
-	switch x := err.(type) {
-	case interface{ Unwrap() error }:
-		unwraped := x.Unwrap()
-		if err != nil {
-			elms = append(elms, strings.Join([]string{`"Cause":`, EncodeJSON(unwraped)}, ""))
-		}
-	case interface{ Unwrap() []error }:
-		unwraped := x.Unwrap()
+	if fields := encodeFields(ExtraFields(err)); len(fields) > 0 {
+		elms = append(elms, strings.Join([]string{`"Fields":`, fields}, ""))
+	}
+	if unwraped, ok := unwrapMulti(err); ok {
 		if len(unwraped) > 0 {
 			causes := []string{}
 			for _, c := range unwraped {
@@ -295,6 +339,10 @@ func encodeJSON(err error) string {
 			}
 			elms = append(elms, strings.Join([]string{`"Cause":[`, strings.Join(causes, ","), "]"}, ""))
 		}
+	} else if x, ok := err.(interface{ Unwrap() error }); ok {
+		elms = append(elms, strings.Join([]string{`"Cause":`, EncodeJSON(x.Unwrap())}, ""))
+	} else if cause := unwrapCause(err); cause != nil {
+		elms = append(elms, strings.Join([]string{`"Cause":`, EncodeJSON(cause)}, ""))
 	}
 	return strings.Join([]string{"{", strings.Join(elms, ","), "}"}, "")
 }
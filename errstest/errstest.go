@@ -0,0 +1,182 @@
+// Package errstest implements assertions and golden-file helpers for error trees of errs package.
+package errstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/goark/errs"
+)
+
+// Option type is self-referential function type for functions in errstest package. (functional options pattern)
+type Option func(*config)
+
+// config is configuration for functions in errstest package.
+type config struct {
+	depth         int
+	deterministic bool
+}
+
+// AtDepth function returns Option function value.
+// Assertions look for the error only at the depth of error tree (0 is the root error).
+func AtDepth(depth int) Option {
+	return func(c *config) {
+		c.depth = depth
+	}
+}
+
+// Deterministic function returns Option function value.
+// Golden function compares normalized JSON data (see Normalize function).
+func Deterministic() Option {
+	return func(c *config) {
+		c.deterministic = true
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{depth: -1}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// HasType function asserts that error tree contains the error type (e.g. "*fs.PathError").
+func HasType(t testing.TB, err error, typeName string, opts ...Option) bool {
+	t.Helper()
+	c := newConfig(opts)
+	if find(err, c.depth, func(e error) bool { return errs.TypeName(e) == typeName }) {
+		return true
+	}
+	t.Errorf("errstest: type %v is not found%s in %+v", typeName, c.depthString(), err)
+	return false
+}
+
+// HasCode function asserts that error tree contains *errs.Error instance with the "code" context value.
+func HasCode(t testing.TB, err error, code interface{}, opts ...Option) bool {
+	t.Helper()
+	c := newConfig(opts)
+	if find(err, c.depth, func(e error) bool { return hasContext(e, "code", code) }) {
+		return true
+	}
+	t.Errorf("errstest: code %v is not found%s in %+v", code, c.depthString(), err)
+	return false
+}
+
+// HasContext function asserts that error tree contains *errs.Error instance with the context value.
+// Context values are compared in JSON representation, so the assertion succeeds after JSON round-trip.
+func HasContext(t testing.TB, err error, key string, value interface{}, opts ...Option) bool {
+	t.Helper()
+	c := newConfig(opts)
+	if find(err, c.depth, func(e error) bool { return hasContext(e, key, value) }) {
+		return true
+	}
+	t.Errorf("errstest: context %v=%v is not found%s in %+v", key, value, c.depthString(), err)
+	return false
+}
+
+func (c *config) depthString() string {
+	if c.depth < 0 {
+		return ""
+	}
+	return fmt.Sprintf(" at depth %d", c.depth)
+}
+
+// find reports whether error tree contains an error that satisfies fn.
+func find(err error, depth int, fn func(error) bool) bool {
+	found := false
+	errs.Walk(err, func(e error, d int) bool {
+		if (depth < 0 || depth == d) && fn(e) {
+			found = true
+		}
+		return !found && (depth < 0 || d < depth)
+	})
+	return found
+}
+
+// hasContext reports whether err is *errs.Error instance with the context value.
+func hasContext(err error, key string, value interface{}) bool {
+	e, ok := err.(*errs.Error)
+	if !ok {
+		return false
+	}
+	v, ok := e.Context[key]
+	if !ok {
+		return false
+	}
+	if reflect.DeepEqual(v, value) {
+		return true
+	}
+	b1, err1 := json.Marshal(v)
+	b2, err2 := json.Marshal(value)
+	return err1 == nil && err2 == nil && string(b1) == string(b2)
+}
+
+var (
+	timestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:Z|[+-]\d{2}:?\d{2})?`)
+	addressPattern   = regexp.MustCompile(`0x[0-9a-fA-F]{6,}`)
+)
+
+// Normalize function returns indented JSON data of error tree for deterministic comparison.
+// Values of "function" and "stack" context, timestamps and pointer addresses are replaced with placeholders.
+func Normalize(err error) string {
+	var v interface{}
+	dec := json.NewDecoder(strings.NewReader(errs.EncodeJSON(err)))
+	dec.UseNumber()
+	if e := dec.Decode(&v); e != nil {
+		return errs.EncodeJSON(err)
+	}
+	buf := &strings.Builder{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if e := enc.Encode(normalize(v)); e != nil {
+		return errs.EncodeJSON(err)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func normalize(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		for k, vv := range x {
+			x[k] = normalize(vv)
+		}
+		if ctx, ok := x["Context"].(map[string]interface{}); ok {
+			for k, placeholder := range map[string]string{"function": "<function>", "stack": "<stack>"} {
+				if _, ok := ctx[k]; ok {
+					ctx[k] = placeholder
+				}
+			}
+		}
+		return x
+	case []interface{}:
+		for i, vv := range x {
+			x[i] = normalize(vv)
+		}
+		return x
+	case string:
+		x = timestampPattern.ReplaceAllString(x, "<timestamp>")
+		return addressPattern.ReplaceAllString(x, "<addr>")
+	}
+	return v
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,150 @@
+package errstest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// fakeTB records failures of assertions.
+type fakeTB struct {
+	testing.TB
+	msgs []string
+}
+
+func (f *fakeTB) Helper() {}
+func (f *fakeTB) Errorf(format string, args ...interface{}) {
+	f.msgs = append(f.msgs, fmt.Sprintf(format, args...))
+}
+func (f *fakeTB) Fatalf(format string, args ...interface{}) {
+	f.msgs = append(f.msgs, fmt.Sprintf(format, args...))
+}
+
+var testErr = errs.New(
+	"file open error",
+	errs.WithCause(errs.Wrap(&os.PathError{Op: "open", Path: "not-exist.txt", Err: os.ErrNotExist}, errs.WithContext("code", 404))),
+	errs.WithContext("path", "not-exist.txt"),
+)
+
+func TestAssertions(t *testing.T) {
+	decoded, err := errs.DecodeJSON([]byte(errs.EncodeJSON(testErr)))
+	if err != nil {
+		t.Fatalf("DecodeJSON() is %v, want <nil>", err)
+	}
+	testCases := []struct {
+		name   string
+		assert func(testing.TB, error) bool
+		ok     bool
+	}{
+		{name: "type", assert: func(tb testing.TB, err error) bool { return HasType(tb, err, "*fs.PathError") }, ok: true},
+		{name: "type at depth 2", assert: func(tb testing.TB, err error) bool { return HasType(tb, err, "*fs.PathError", AtDepth(2)) }, ok: true},
+		{name: "type at depth 1", assert: func(tb testing.TB, err error) bool { return HasType(tb, err, "*fs.PathError", AtDepth(1)) }, ok: false},
+		{name: "type not found", assert: func(tb testing.TB, err error) bool { return HasType(tb, err, "*url.Error") }, ok: false},
+		{name: "code", assert: func(tb testing.TB, err error) bool { return HasCode(tb, err, 404) }, ok: true},
+		{name: "code at depth 1", assert: func(tb testing.TB, err error) bool { return HasCode(tb, err, 404, AtDepth(1)) }, ok: true},
+		{name: "code at depth 0", assert: func(tb testing.TB, err error) bool { return HasCode(tb, err, 404, AtDepth(0)) }, ok: false},
+		{name: "context", assert: func(tb testing.TB, err error) bool { return HasContext(tb, err, "path", "not-exist.txt", AtDepth(0)) }, ok: true},
+		{name: "context value mismatch", assert: func(tb testing.TB, err error) bool { return HasContext(tb, err, "path", "other.txt") }, ok: false},
+		{name: "nil error", assert: func(tb testing.TB, _ error) bool { return HasType(tb, nil, "*errs.Error") }, ok: false},
+	}
+
+	for _, tc := range testCases {
+		for _, e := range []error{testErr, decoded} {
+			tb := &fakeTB{}
+			if ok := tc.assert(tb, e); ok != tc.ok {
+				t.Errorf("%s: assertion for %T is %v, want %v", tc.name, e, ok, tc.ok)
+			}
+			if failed := len(tb.msgs) > 0; failed == tc.ok {
+				t.Errorf("%s: failure messages are %v, want failed=%v", tc.name, tb.msgs, !tc.ok)
+			}
+		}
+	}
+}
+
+func TestAssertionsErrors(t *testing.T) {
+	err := errs.Join(os.ErrInvalid, errs.Wrap(os.ErrNotExist, errs.WithContext("code", "E1")))
+	tb := &fakeTB{}
+	if !HasCode(tb, err, "E1", AtDepth(1)) {
+		t.Errorf("HasCode() is false, want true: %v", tb.msgs)
+	}
+	if !HasType(tb, err, "*errors.errorString", AtDepth(2)) {
+		t.Errorf("HasType() is false, want true: %v", tb.msgs)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	err := errs.New(
+		"timeout at 2026-01-02T03:04:05.123Z",
+		errs.WithContext("ptr", fmt.Sprintf("%p", &time.Time{})),
+		errs.WithContext("stack", []string{"main.main /src/main.go:1"}),
+	)
+	want := `{
+  "Context": {
+    "function": "<function>",
+    "ptr": "<addr>",
+    "stack": "<stack>"
+  },
+  "Err": {
+    "Msg": "timeout at <timestamp>",
+    "Type": "*errors.errorString"
+  },
+  "Type": "*errs.Error"
+}`
+	if got := Normalize(err); got != want {
+		t.Errorf("Normalize() is\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestGolden(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "error.golden")
+
+	tb := &fakeTB{}
+	if Golden(tb, testErr, path, Deterministic()) {
+		t.Error("Golden() without golden file is true, want false")
+	}
+
+	*update = true
+	ok := Golden(tb, testErr, path, Deterministic())
+	*update = false
+	if !ok {
+		t.Errorf("Golden() with update flag is false, want true: %v", tb.msgs)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden file is not written: %v", err)
+	}
+	if !strings.Contains(string(b), `"function": "<function>"`) {
+		t.Errorf("golden file is %s, want normalized JSON", b)
+	}
+
+	tb = &fakeTB{}
+	if !Golden(tb, testErr, path, Deterministic()) {
+		t.Errorf("Golden() is false, want true: %v", tb.msgs)
+	}
+	if Golden(tb, errs.New("other error"), path, Deterministic()) {
+		t.Error("Golden() with other error is true, want false")
+	}
+	if Golden(tb, testErr, path) {
+		t.Error("Golden() without Deterministic option is true, want false")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,59 @@
+package errstest
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goark/errs"
+)
+
+var update = flag.Bool("errstest.update", false, "update golden files of errstest package")
+
+// Golden function compares JSON data of error tree with the golden file.
+// If -errstest.update flag is set, the golden file is (re)written instead.
+func Golden(t testing.TB, err error, path string, opts ...Option) bool {
+	t.Helper()
+	c := newConfig(opts)
+	got := []byte(errs.EncodeJSON(err))
+	if c.deterministic {
+		got = []byte(Normalize(err))
+	}
+	got = append(got, '\n')
+	if *update {
+		if e := os.MkdirAll(filepath.Dir(path), 0o750); e != nil {
+			t.Fatalf("errstest: cannot create directory of golden file %v: %v", path, e)
+		}
+		if e := os.WriteFile(path, got, 0o600); e != nil {
+			t.Fatalf("errstest: cannot write golden file %v: %v", path, e)
+		}
+		return true
+	}
+	want, e := os.ReadFile(filepath.Clean(path))
+	if e != nil {
+		t.Errorf("errstest: cannot read golden file %v (run with -errstest.update flag): %v", path, e)
+		return false
+	}
+	if !bytes.Equal(bytes.TrimSpace(got), bytes.TrimSpace(want)) {
+		t.Errorf("errstest: error tree does not match golden file %v\ngot:\n%s\nwant:\n%s", path, got, want)
+		return false
+	}
+	return true
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
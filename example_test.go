@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"sync"
 
 	"github.com/goark/errs"
@@ -35,7 +36,7 @@ func ExampleEncodeJSON() {
 	_, err := os.Open("not-exist.txt")
 	fmt.Printf("%v", errs.EncodeJSON(err))
 	// Output:
-	// {"Type":"*fs.PathError","Msg":"open not-exist.txt: no such file or directory","Cause":{"Type":"syscall.Errno","Msg":"no such file or directory"}}
+	// {"Type":"*fs.PathError","Msg":"open not-exist.txt: no such file or directory","Fields":{"Op":"open","Path":"not-exist.txt"},"Cause":{"Type":"syscall.Errno","Msg":"no such file or directory","Fields":{"Errno":2,"Name":"ENOENT"}}}
 }
 
 func ExampleJoin() {
@@ -70,6 +71,29 @@ func ExampleErrors() {
 	// error ount = 100000
 }
 
+func ExampleFingerprint() {
+	fp1 := errs.Fingerprint(errs.New("user 123 not found", errs.WithContext("user", 123)))
+	fp2 := errs.Fingerprint(errs.New("user 456 not found", errs.WithContext("user", 456)))
+	fmt.Println(fp1 == fp2)
+	// Output:
+	// true
+}
+
+func ExampleHandle() {
+	parse := func(s string) (n int, err error) {
+		defer errs.Handle(&err, errs.WithContext("input", s))
+		n = errs.Try(strconv.Atoi(s))
+		errs.Check(nil)
+		return n, nil
+	}
+	fmt.Println(parse("42"))
+	_, err := parse("foo")
+	fmt.Println(err)
+	// Output:
+	// 42 <nil>
+	// strconv.Atoi: parsing "foo": invalid syntax
+}
+
 /* Copyright 2019-2023 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
@@ -0,0 +1,140 @@
+// Package expvarhook implements hooks of errs package that count created errors in expvar variables.
+//
+// Counts are shown in /debug/vars of expvar package as follows:
+//
+//	"errs": {
+//		"total": 3,
+//		"function": {"main.checkFileOpen": 2, "main.run": 1},
+//		"type": {"*fs.PathError": 2, "*errors.errorString": 1},
+//		"code": {"E001": 1}
+//	}
+package expvarhook
+
+import (
+	"expvar"
+	"fmt"
+	"sync"
+
+	"github.com/goark/errs"
+)
+
+// DefaultName is the default name of expvar variable.
+const DefaultName = "errs"
+
+// Counter is a set of expvar counters of created errors.
+type Counter struct {
+	vars       *expvar.Map
+	total      *expvar.Int
+	byFunction *expvar.Map
+	byType     *expvar.Map
+	byCode     *expvar.Map
+	mu         sync.Mutex
+	unregister []func()
+}
+
+var (
+	mu       sync.Mutex
+	counters = map[string]*Counter{}
+)
+
+// Register function publishes expvar variable of the name (DefaultName if empty),
+// and registers hooks of errs package that count created errors in the variable.
+// Errors are counted by "function" context, type and "code" context.
+// The type is the type of cause error (or wrapped error if no cause) of *errs.Error instance, and "*errs.Errors" for errs.Join function.
+// Calling Register function with the same name returns the same Counter instance.
+func Register(name string) *Counter {
+	if len(name) == 0 {
+		name = DefaultName
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if c, ok := counters[name]; ok {
+		c.start()
+		return c
+	}
+	c := &Counter{
+		vars:       &expvar.Map{},
+		total:      &expvar.Int{},
+		byFunction: &expvar.Map{},
+		byType:     &expvar.Map{},
+		byCode:     &expvar.Map{},
+	}
+	c.vars.Set("total", c.total)
+	c.vars.Set("function", c.byFunction)
+	c.vars.Set("type", c.byType)
+	c.vars.Set("code", c.byCode)
+	expvar.Publish(name, c.vars)
+	counters[name] = c
+	c.start()
+	return c
+}
+
+// start registers hooks if not registered.
+func (c *Counter) start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.unregister) > 0 {
+		return
+	}
+	c.unregister = []func(){
+		errs.RegisterHook(c.countError),
+		errs.RegisterErrorsHook(c.countErrors),
+	}
+}
+
+// Stop method unregisters hooks. Counts in expvar variable are kept.
+func (c *Counter) Stop() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, fn := range c.unregister {
+		fn()
+	}
+	c.unregister = nil
+}
+
+// Vars method returns expvar variable of counts.
+func (c *Counter) Vars() *expvar.Map {
+	if c == nil {
+		return nil
+	}
+	return c.vars
+}
+
+func (c *Counter) countError(e *errs.Error) {
+	c.total.Add(1)
+	if fn, ok := e.Context["function"]; ok {
+		c.byFunction.Add(fmt.Sprint(fn), 1)
+	}
+	switch {
+	case e.Cause != nil:
+		c.byType.Add(fmt.Sprintf("%T", e.Cause), 1)
+	case e.Err != nil:
+		c.byType.Add(fmt.Sprintf("%T", e.Err), 1)
+	}
+	if code, ok := e.Context["code"]; ok {
+		c.byCode.Add(fmt.Sprint(code), 1)
+	}
+}
+
+func (c *Counter) countErrors(es *errs.Errors) {
+	c.total.Add(1)
+	c.byType.Add(fmt.Sprintf("%T", es), 1)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
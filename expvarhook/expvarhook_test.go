@@ -0,0 +1,79 @@
+package expvarhook_test
+
+import (
+	"encoding/json"
+	"expvar"
+	"io"
+	"testing"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/expvarhook"
+)
+
+type counts struct {
+	Total    int            `json:"total"`
+	Function map[string]int `json:"function"`
+	Type     map[string]int `json:"type"`
+	Code     map[string]int `json:"code"`
+}
+
+func load(t *testing.T, name string) counts {
+	t.Helper()
+	v := expvar.Get(name)
+	if v == nil {
+		t.Fatalf("expvar.Get(%v) is <nil>, want variable", name)
+	}
+	var c counts
+	if err := json.Unmarshal([]byte(v.String()), &c); err != nil {
+		t.Fatalf("json.Unmarshal(%v) is \"%v\", want <nil>", v.String(), err)
+	}
+	return c
+}
+
+func TestRegister(t *testing.T) {
+	c := expvarhook.Register("errs_test")
+	if c2 := expvarhook.Register("errs_test"); c2 != c {
+		t.Errorf("Register() returns another instance, want the same")
+	}
+	_ = errs.New("message", errs.WithContext("code", "E001"))
+	_ = errs.Wrap(io.EOF)
+	_ = errs.Join(io.EOF, io.ErrUnexpectedEOF)
+	_ = errs.NewErrors() // empty container is not counted
+	_ = errs.NewErrors(errs.WithDedup(nil))
+	c.Stop()
+	_ = errs.New("not counted")
+
+	got := load(t, "errs_test")
+	if got.Total != 3 {
+		t.Errorf("total is %v, want %v", got.Total, 3)
+	}
+	if n := got.Function["github.com/goark/errs/expvarhook_test.TestRegister"]; n != 2 {
+		t.Errorf("function count is %v, want %v", n, 2)
+	}
+	for typ, want := range map[string]int{"*errors.errorString": 2, "*errs.Errors": 1} {
+		if n := got.Type[typ]; n != want {
+			t.Errorf("type count of %v is %v, want %v", typ, n, want)
+		}
+	}
+	if n := got.Code["E001"]; n != 1 {
+		t.Errorf("code count is %v, want %v", n, 1)
+	}
+	if c.Vars() != expvar.Get("errs_test") {
+		t.Error("Vars() is not published variable")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,156 @@
+package errs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// FingerprintOption type is self-referential function type for Fingerprint function. (functional options pattern)
+type FingerprintOption func(*fingerprinter)
+
+// WithFingerprintKeys function returns FingerprintOption function value.
+// Context values of these keys take part in fingerprint
+// ("function" and "code" context values are always used).
+func WithFingerprintKeys(keys ...string) FingerprintOption {
+	return func(f *fingerprinter) {
+		f.keys = append(f.keys, keys...)
+	}
+}
+
+// WithoutFingerprintKeys function returns FingerprintOption function value.
+// Context values of these keys are left out of fingerprint, even if they are default keys ("function" and "code")
+// or added by WithFingerprintKeys option.
+func WithoutFingerprintKeys(keys ...string) FingerprintOption {
+	return func(f *fingerprinter) {
+		if f.excludes == nil {
+			f.excludes = map[string]bool{}
+		}
+		for _, k := range keys {
+			f.excludes[k] = true
+		}
+	}
+}
+
+// fingerprinter is working area for Fingerprint function.
+type fingerprinter struct {
+	keys     []string
+	excludes map[string]bool
+	buf      strings.Builder
+}
+
+// Fingerprint function returns stable hash (hex string of SHA-256) built from the shape of error tree.
+// Error types, "function" and "code" context values, and normalized messages take part in the hash.
+// Numbers, UUIDs, hex values, paths and quoted values in messages are stripped,
+// and other context values are left out (use WithFingerprintKeys option to include them,
+// and WithoutFingerprintKeys option to exclude default keys).
+// The result is the same across process restarts and after JSON round-trip (EncodeJSON and DecodeJSON functions).
+// It returns empty string if err is nil.
+func Fingerprint(err error, opts ...FingerprintOption) string {
+	if isNilError(err) {
+		return ""
+	}
+	f := &fingerprinter{keys: []string{"function", "code"}}
+	for _, opt := range opts {
+		opt(f)
+	}
+	keys := f.keys[:0]
+	for _, k := range f.keys {
+		if !f.excludes[k] {
+			keys = append(keys, k)
+		}
+	}
+	f.keys = keys
+	sort.Strings(f.keys)
+	f.write(err)
+	sum := sha256.Sum256([]byte(f.buf.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+func (f *fingerprinter) write(err error) {
+	if isNilError(err) {
+		f.buf.WriteString("nil;")
+		return
+	}
+	f.buf.WriteString(TypeName(err))
+	f.buf.WriteByte('{')
+	var children []error
+	switch e := err.(type) {
+	case *Error:
+		done := map[string]bool{}
+		for _, k := range f.keys {
+			if done[k] {
+				continue
+			}
+			done[k] = true
+			if v, ok := e.Context[k]; ok {
+				fmt.Fprintf(&f.buf, "%q=%s;", k, contextValueString(v))
+			}
+		}
+		children = []error{e.Err, e.Cause}
+	case *Errors:
+		children = e.Unwrap()
+	default:
+		fmt.Fprintf(&f.buf, "%q;", normalizeMessage(err.Error()))
+		children = Unwraps(err)
+	}
+	for _, c := range children {
+		f.write(c)
+	}
+	f.buf.WriteByte('}')
+}
+
+// contextValueString returns normalized string of context value (the same after JSON round-trip).
+func contextValueString(v interface{}) string {
+	if b, err := json.Marshal(v); err == nil {
+		return string(b)
+	}
+	return fmt.Sprint(v)
+}
+
+var (
+	quotedPattern = regexp.MustCompile("\"(?:[^\"\\\\]|\\\\.)*\"|'(?:[^'\\\\]|\\\\.)*'|`[^`]*`")
+	uuidPattern   = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	pathPattern   = regexp.MustCompile(`(?:[A-Za-z]:)?[^\s:"'` + "`" + `]*[/\\][^\s:"'` + "`" + `]+`)
+	hexPattern    = regexp.MustCompile(`\b0[xX][0-9a-fA-F]+\b`)
+	numberPattern = regexp.MustCompile(`[0-9]+(?:\.[0-9]+)?`)
+)
+
+// normalizeMessage returns message with volatile values stripped.
+func normalizeMessage(msg string) string {
+	msg = quotedPattern.ReplaceAllString(msg, `"*"`)
+	msg = uuidPattern.ReplaceAllString(msg, "<uuid>")
+	msg = pathPattern.ReplaceAllString(msg, "<path>")
+	msg = hexPattern.ReplaceAllString(msg, "<hex>")
+	msg = numberPattern.ReplaceAllString(msg, "<num>")
+	return msg
+}
+
+// isNilError returns true if err is nil or typed nil pointer (e.g. (*Error)(nil)).
+func isNilError(err error) bool {
+	if err == nil {
+		return true
+	}
+	v := reflect.ValueOf(err)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
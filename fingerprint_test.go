@@ -0,0 +1,108 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	openError := func(path string, id int) error {
+		return New(
+			fmt.Sprintf("cannot open file (id=%d)", id),
+			WithCause(&os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}),
+			WithContext("path", path),
+			WithContext("code", "E001"),
+		)
+	}
+	testCases := []struct {
+		err1, err2 error
+		opts       []FingerprintOption
+		same       bool
+	}{
+		{err1: nil, err2: nil, same: true},
+		{err1: (*Error)(nil), err2: nil, same: true},
+		{err1: (*Errors)(nil), err2: (*os.PathError)(nil), same: true},
+		{err1: Wrap(io.EOF, WithCause((*Error)(nil))), err2: Wrap(io.EOF), same: true},
+		{err1: errors.Join(io.EOF, (*Error)(nil)), err2: errors.Join(io.EOF, (*Errors)(nil)), same: true},
+		{err1: openError("/var/data/a.txt", 1), err2: openError("/home/user/b.txt", 23), same: true},
+		{err1: openError("/var/data/a.txt", 1), err2: openError("/home/user/b.txt", 23), opts: []FingerprintOption{WithFingerprintKeys("path")}, same: false},
+		{err1: openError("/var/data/a.txt", 1), err2: New("cannot open file (id=1)", WithCause(os.ErrNotExist), WithContext("code", "E001")), same: false},
+		{err1: New(`invalid value "foo"`), err2: New(`invalid value "bar"`), same: true},
+		{err1: New("request 123e4567-e89b-12d3-a456-426614174000 failed"), err2: New("request 00000000-0000-0000-0000-000000000000 failed"), same: true},
+		{err1: New("error", WithContext("code", 1)), err2: New("error", WithContext("code", 2)), same: false},
+		{err1: New("error", WithContext("code", 1)), err2: New("error", WithContext("code", 2)), opts: []FingerprintOption{WithoutFingerprintKeys("code")}, same: true},
+		{err1: New("error", WithContext("function", "pkg.Old")), err2: New("error", WithContext("function", "pkg.New")), same: false},
+		{err1: New("error", WithContext("function", "pkg.Old")), err2: New("error", WithContext("function", "pkg.New")), opts: []FingerprintOption{WithoutFingerprintKeys("function")}, same: true},
+		{err1: openError("/var/data/a.txt", 1), err2: openError("/home/user/b.txt", 23), opts: []FingerprintOption{WithFingerprintKeys("path"), WithoutFingerprintKeys("path")}, same: true},
+		{err1: Join(io.EOF, os.ErrInvalid), err2: Join(os.ErrInvalid, io.EOF), same: false},
+		{err1: errors.New("error 1"), err2: errors.New("error 2"), same: true},
+		{err1: errors.New("error"), err2: Wrap(errors.New("error")), same: false},
+	}
+
+	for _, tc := range testCases {
+		fp1, fp2 := Fingerprint(tc.err1, tc.opts...), Fingerprint(tc.err2, tc.opts...)
+		if (fp1 == fp2) != tc.same {
+			t.Errorf("Fingerprint(%v) == Fingerprint(%v) is %v, want %v", tc.err1, tc.err2, fp1 == fp2, tc.same)
+		}
+	}
+}
+
+func TestFingerprintRoundTrip(t *testing.T) {
+	testCases := []struct {
+		err error
+	}{
+		{err: errTest},
+		{err: wrapedErrTest2},
+		{err: New("error", WithContext("code", 404), WithContext("rate", 0.5))},
+		{err: Wrap(os.ErrInvalid, WithCause(errors.Join(io.EOF, io.ErrUnexpectedEOF)))},
+		{err: Join(os.ErrInvalid, Wrap(&os.PathError{Op: "open", Path: "a.txt", Err: os.ErrNotExist}))},
+	}
+
+	for _, tc := range testCases {
+		err, e := DecodeJSON([]byte(EncodeJSON(tc.err)))
+		if e != nil {
+			t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", tc.err, e)
+			continue
+		}
+		opt := WithFingerprintKeys("rate")
+		if fp1, fp2 := Fingerprint(tc.err, opt), Fingerprint(err, opt); fp1 != fp2 {
+			t.Errorf("Fingerprint(%v) is %v, want %v (after JSON round-trip)", tc.err, fp2, fp1)
+		}
+	}
+}
+
+func TestNormalizeMessage(t *testing.T) {
+	testCases := []struct {
+		msg  string
+		want string
+	}{
+		{msg: "open /var/data/a.txt: no such file or directory", want: "open <path>: no such file or directory"},
+		{msg: `parse "abc": invalid syntax at 12`, want: `parse "*": invalid syntax at <num>`},
+		{msg: "object at 0xc000123abc", want: "object at <hex>"},
+		{msg: "id 123e4567-e89b-12d3-a456-426614174000 not found", want: "id <uuid> not found"},
+	}
+
+	for _, tc := range testCases {
+		if got := normalizeMessage(tc.msg); got != tc.want {
+			t.Errorf("normalizeMessage(%q) is %q, want %q", tc.msg, got, tc.want)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
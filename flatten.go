@@ -0,0 +1,146 @@
+package errs
+
+import (
+	"errors"
+	"reflect"
+)
+
+// joinErrorType is type of errors.Join function result in standard package.
+var joinErrorType = reflect.TypeOf(errors.Join(errors.New("")))
+
+// WithFlatten function returns ErrorsOption function value.
+// This function sets Errors to flatten nested multi-errors
+// (*Errors, *Collector, errors.Join result and so on) in Errors.Add method (see Flatten function).
+func WithFlatten() ErrorsOption {
+	return func(es *Errors) {
+		es.flat = true
+	}
+}
+
+// Flatten function collapses nested multi-errors into a single level *Errors instance.
+// Multi-errors are *Errors, *Collector, errors.Join result in standard package,
+// and errors with Errors() or WrappedErrors() method
+// (go.uber.org/multierr and github.com/hashicorp/go-multierror packages).
+// Dropped errors and occurrence counts of nested *Errors are kept.
+// If err is not multi-error, Flatten function returns err itself.
+// If err has no errors, Flatten function returns nil.
+func Flatten(err error) error {
+	if _, ok := multiErrors(err); !ok {
+		return err
+	}
+	if es := flatten(err); es != nil {
+		return es
+	}
+	return nil
+}
+
+// JoinFlat function returns Errors instance same as Join function,
+// but nested multi-errors in errlist are flattened (see Flatten function).
+// The Errors instance is created with WithFlatten option, so Errors.Add method flattens errors too.
+func JoinFlat(errlist ...error) error {
+	es := flatten(errlist...)
+	if es == nil {
+		return nil
+	}
+	es.flat = true
+	errorsHooks.call(es)
+	return es
+}
+
+// flatten returns *Errors instance that has flattened errors in errlist (nil if there is no error).
+func flatten(errlist ...error) *Errors {
+	f := &flattener{}
+	for _, err := range errlist {
+		f.walk(err, occurrence{})
+	}
+	if len(f.errs) == 0 {
+		return nil
+	}
+	es := &Errors{errs: f.errs, dropped: f.dropped}
+	if f.tracked {
+		es.occurs = f.occurs
+	}
+	return es
+}
+
+// flattener is working area for Flatten function.
+type flattener struct {
+	errs    []error
+	occurs  []occurrence
+	tracked bool
+	dropped int
+}
+
+// walk collects errors in multi-error recursively.
+func (f *flattener) walk(err error, occ occurrence) {
+	if err == nil {
+		return
+	}
+	if es, ok := err.(*Errors); ok {
+		if es == nil {
+			return
+		}
+		es.mu.RLock()
+		list, occurs := es.list(), es.listOccurs()
+		f.dropped += es.dropped
+		es.mu.RUnlock()
+		for i, e := range list {
+			if i < len(occurs) {
+				f.walk(e, occurs[i])
+			} else {
+				f.walk(e, occurrence{})
+			}
+		}
+		return
+	}
+	if list, ok := multiErrors(err); ok {
+		for _, e := range list {
+			f.walk(e, occurrence{})
+		}
+		return
+	}
+	if occ.count > 0 {
+		f.tracked = true
+	} else {
+		occ.count = 1
+	}
+	f.errs = append(f.errs, err)
+	f.occurs = append(f.occurs, occurrence{count: occ.count, first: occ.first, last: occ.last})
+}
+
+// multiErrors returns errors in multi-error (see Flatten function).
+// *Error instance and other errors with Unwrap() []error method (e.g. fmt.Errorf function with multiple %w verbs)
+// are not multi-error, because they have their own message.
+func multiErrors(err error) ([]error, bool) {
+	switch e := err.(type) {
+	case nil:
+		return nil, false
+	case *Errors:
+		return e.Unwrap(), true
+	case *Collector:
+		return e.Unwrap(), true
+	case interface{ Errors() []error }:
+		return e.Errors(), true
+	case interface{ WrappedErrors() []error }:
+		return e.WrappedErrors(), true
+	}
+	if reflect.TypeOf(err) == joinErrorType {
+		return Unwraps(err), true
+	}
+	return nil, false
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,204 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+// multiErrorsTest is multi-error like go.uber.org/multierr package.
+type multiErrorsTest []error
+
+func (e multiErrorsTest) Error() string   { return "multiple errors" }
+func (e multiErrorsTest) Errors() []error { return e }
+
+// wrappedErrorsTest is multi-error like github.com/hashicorp/go-multierror package.
+type wrappedErrorsTest struct{ errs []error }
+
+func (e *wrappedErrorsTest) Error() string          { return "wrapped errors" }
+func (e *wrappedErrorsTest) WrappedErrors() []error { return e.errs }
+
+func TestFlatten(t *testing.T) {
+	wrapped := Wrap(Join(io.EOF, io.ErrUnexpectedEOF))
+	bounded := NewErrors(WithCapacity(1, KeepFirst))
+	bounded.Add(io.EOF, io.ErrUnexpectedEOF)
+	testCases := []struct {
+		err  error
+		want []error
+		drop int
+	}{
+		{err: Join(io.EOF, Join(os.ErrNotExist, errors.Join(io.ErrUnexpectedEOF, os.ErrExist))), want: []error{io.EOF, os.ErrNotExist, io.ErrUnexpectedEOF, os.ErrExist}},
+		{err: errors.Join(NewCollector(io.EOF), multiErrorsTest{os.ErrNotExist, &wrappedErrorsTest{errs: []error{os.ErrExist}}}), want: []error{io.EOF, os.ErrNotExist, os.ErrExist}},
+		{err: Join(wrapped, os.ErrNotExist), want: []error{wrapped, os.ErrNotExist}},
+		{err: Join(bounded, os.ErrNotExist), want: []error{io.EOF, os.ErrNotExist}, drop: 1},
+	}
+
+	for _, tc := range testCases {
+		res, ok := Flatten(tc.err).(*Errors)
+		if !ok {
+			t.Errorf("Flatten(%v) is %T, want *errs.Errors", tc.err, Flatten(tc.err))
+			continue
+		}
+		got := res.Unwrap()
+		if len(got) != len(tc.want) {
+			t.Errorf("Flatten(%v) is %v, want %v", tc.err, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("Flatten(%v)[%d] is %v, want %v", tc.err, i, got[i], tc.want[i])
+			}
+		}
+		if res.Dropped() != tc.drop {
+			t.Errorf("Flatten(%v).Dropped() is %v, want %v", tc.err, res.Dropped(), tc.drop)
+		}
+	}
+}
+
+func TestFlattenNotMulti(t *testing.T) {
+	testCases := []struct {
+		err  error
+		want error
+	}{
+		{err: nil, want: nil},
+		{err: io.EOF, want: io.EOF},
+		{err: &Errors{}, want: nil},
+		{err: Join(&Errors{}, NewCollector()), want: nil},
+		{err: (*Errors)(nil), want: nil},
+		{err: (*Collector)(nil), want: nil},
+		{err: errors.Join((*Errors)(nil)), want: nil},
+	}
+
+	for _, tc := range testCases {
+		if got := Flatten(tc.err); got != tc.want {
+			t.Errorf("Flatten(%v) is %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestJoinFlat(t *testing.T) {
+	bounded := NewErrors(WithCapacity(1, KeepFirst))
+	bounded.Add(io.EOF, io.ErrUnexpectedEOF)
+	testCases := []struct {
+		errs []error
+		want []error
+		drop int
+	}{
+		{errs: []error{errors.Join(io.EOF, errors.Join(os.ErrNotExist, os.ErrExist)), io.ErrUnexpectedEOF}, want: []error{io.EOF, os.ErrNotExist, os.ErrExist, io.ErrUnexpectedEOF}},
+		{errs: []error{Join(io.EOF, Join(os.ErrNotExist)), nil, NewCollector(os.ErrExist)}, want: []error{io.EOF, os.ErrNotExist, os.ErrExist}},
+		{errs: []error{bounded, errors.Join(os.ErrNotExist)}, want: []error{io.EOF, os.ErrNotExist}, drop: 1},
+		{errs: []error{io.EOF}, want: []error{io.EOF}},
+	}
+
+	for _, tc := range testCases {
+		res, ok := JoinFlat(tc.errs...).(*Errors)
+		if !ok {
+			t.Errorf("JoinFlat(%v) is %T, want *errs.Errors", tc.errs, JoinFlat(tc.errs...))
+			continue
+		}
+		got := res.Unwrap()
+		if len(got) != len(tc.want) {
+			t.Errorf("JoinFlat(%v) is %v, want %v", tc.errs, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("JoinFlat(%v)[%d] is %v, want %v", tc.errs, i, got[i], tc.want[i])
+			}
+		}
+		if res.Dropped() != tc.drop {
+			t.Errorf("JoinFlat(%v).Dropped() is %v, want %v", tc.errs, res.Dropped(), tc.drop)
+		}
+		if res.Add(errors.Join(os.ErrClosed, os.ErrClosed)); len(res.Unwrap()) != len(tc.want)+2 {
+			t.Errorf("JoinFlat(%v).Add(errors.Join()) does not flatten: %v", tc.errs, res.Unwrap())
+		}
+	}
+	for _, errs := range [][]error{nil, {nil}, {&Errors{}, errors.Join(nil)}} {
+		if got := JoinFlat(errs...); got != nil {
+			t.Errorf("JoinFlat(%v) is %v, want <nil>", errs, got)
+		}
+	}
+}
+
+func TestFlattenOccurrences(t *testing.T) {
+	es := NewErrors(WithDedup(nil))
+	es.Add(io.EOF, io.EOF)
+	res := Flatten(Join(es, os.ErrNotExist))
+	if got, want := res.Error(), "EOF (2 times)\nfile does not exist"; got != want {
+		t.Errorf("Flatten(%v) is %q, want %q", es, got, want)
+	}
+}
+
+func TestWithFlattenOccurrences(t *testing.T) {
+	nested := NewErrors(WithDedup(nil))
+	nested.Add(io.EOF, io.EOF, io.EOF)
+	testCases := []struct {
+		es   *Errors
+		errs []error
+		want string
+		drop int
+	}{
+		{es: NewErrors(WithFlatten()), errs: []error{Join(nested, (*Errors)(nil)), os.ErrNotExist}, want: "EOF (3 times)\nfile does not exist"},
+		{es: NewErrors(WithFlatten()), errs: []error{os.ErrClosed, nested}, want: "file already closed\nEOF (3 times)"},
+		{es: NewErrors(WithFlatten(), WithDedup(nil)), errs: []error{io.EOF, nested, os.ErrNotExist}, want: "EOF (4 times)\nfile does not exist"},
+		{es: NewErrors(WithFlatten(), WithCapacity(1, KeepFirst)), errs: []error{nested, os.ErrNotExist}, want: "EOF (3 times)\nand 1 more", drop: 1},
+	}
+
+	for _, tc := range testCases {
+		for _, err := range tc.errs {
+			tc.es.Add(err)
+		}
+		if got := tc.es.Error(); got != tc.want {
+			t.Errorf("Errors.Add(%v) is %q, want %q", tc.errs, got, tc.want)
+		}
+		if got := tc.es.Dropped(); got != tc.drop {
+			t.Errorf("Errors.Add(%v).Dropped() is %v, want %v", tc.errs, got, tc.drop)
+		}
+	}
+}
+
+func TestWithFlatten(t *testing.T) {
+	es := NewErrors(WithFlatten())
+	es.Add(Join(io.EOF, errors.Join(os.ErrNotExist)), multiErrorsTest{os.ErrExist})
+	if got, want := es.EncodeJSON(), `{"Type":"*errs.Errors","Errs":[{"Type":"*errors.errorString","Msg":"EOF"},{"Type":"*errors.errorString","Msg":"file does not exist"},{"Type":"*errors.errorString","Msg":"file already exists"}]}`; got != want {
+		t.Errorf("Errors.EncodeJSON() is %v, want %v", got, want)
+	}
+	if !errors.Is(es, os.ErrExist) {
+		t.Errorf("errors.Is(%v, os.ErrExist) is false, want true", es)
+	}
+}
+
+func TestUnwrapsMultiErrors(t *testing.T) {
+	testCases := []struct {
+		err  error
+		json string
+	}{
+		{err: multiErrorsTest{io.EOF, os.ErrNotExist}, json: `{"Type":"errs.multiErrorsTest","Msg":"multiple errors","Cause":[{"Type":"*errors.errorString","Msg":"EOF"},{"Type":"*errors.errorString","Msg":"file does not exist"}]}`},
+		{err: &wrappedErrorsTest{errs: []error{io.EOF}}, json: `{"Type":"*errs.wrappedErrorsTest","Msg":"wrapped errors","Cause":[{"Type":"*errors.errorString","Msg":"EOF"}]}`},
+	}
+
+	for _, tc := range testCases {
+		if len(Unwraps(tc.err)) == 0 {
+			t.Errorf("Unwraps(%v) is empty, want errors", tc.err)
+		}
+		if got := EncodeJSON(tc.err); got != tc.json {
+			t.Errorf("EncodeJSON(%v) is %v, want %v", tc.err, got, tc.json)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -1,3 +1,5 @@
 module github.com/goark/errs
 
 go 1.20
+
+require golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da
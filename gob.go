@@ -0,0 +1,475 @@
+package errs
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+var _ gob.GobEncoder = (*Error)(nil)       //Error type is compatible with gob.GobEncoder interface
+var _ gob.GobDecoder = (*Error)(nil)       //Error type is compatible with gob.GobDecoder interface
+var _ gob.GobEncoder = (*Errors)(nil)      //Errors type is compatible with gob.GobEncoder interface
+var _ gob.GobDecoder = (*Errors)(nil)      //Errors type is compatible with gob.GobDecoder interface
+var _ gob.GobEncoder = (*RemoteError)(nil) //RemoteError type is compatible with gob.GobEncoder interface
+var _ gob.GobDecoder = (*RemoteError)(nil) //RemoteError type is compatible with gob.GobDecoder interface
+
+// gobKind is kind of node in gob data.
+type gobKind uint8
+
+const (
+	gobKindRemote    gobKind = iota // foreign error type (restored as *RemoteError)
+	gobKindError                    // *Error
+	gobKindErrors                   // *Errors
+	gobKindSentinel                 // registered sentinel error
+	gobKindValue                    // registered error type
+	gobKindCollector                // *Collector
+)
+
+// gobNode is intermediate structure for gob encoding of error instance.
+type gobNode struct {
+	Kind     gobKind
+	Type     string
+	Msg      string
+	WrapFlag bool
+	MsgFlag  bool
+	Public   string // public message of *Error (see WithPublicMessage function)
+	Multi    bool
+	Err      *gobNode
+	Cause    *gobNode
+	Children []*gobNode
+	Context  []gobContextValue
+	Fields   []byte           // JSON data of extra fields (see ExtraFields function)
+	Dropped  int              // number of dropped errors in *Errors
+	Occurs   []occurrenceData // occurrences of errors in *Errors (dedup mode)
+	Value    []byte
+}
+
+// gobContextValue is a context value in gob data.
+// The value is encoded by gob (JSON data if gob encoding fails).
+type gobContextValue struct {
+	Key  string
+	Gob  []byte
+	JSON []byte
+}
+
+// gobHolder is a wrapper for gob encoding of interface value.
+type gobHolder struct {
+	V interface{}
+}
+
+// gobRegistry is registry of foreign error types and sentinel errors for gob encoding.
+var gobRegistry = struct {
+	mu        sync.RWMutex
+	types     map[reflect.Type]bool
+	sentinels map[string]error
+}{
+	types:     map[reflect.Type]bool{},
+	sentinels: map[string]error{},
+}
+
+func init() {
+	gob.RegisterName("github.com/goark/errs.*Error", (*Error)(nil))
+	gob.RegisterName("github.com/goark/errs.*Errors", (*Errors)(nil))
+	gob.RegisterName("github.com/goark/errs.*RemoteError", (*RemoteError)(nil))
+	RegisterGobSentinel(
+		io.EOF,
+		io.ErrUnexpectedEOF,
+		io.ErrShortWrite,
+		io.ErrShortBuffer,
+		io.ErrNoProgress,
+		io.ErrClosedPipe,
+		os.ErrInvalid,
+		os.ErrPermission,
+		os.ErrExist,
+		os.ErrNotExist,
+		os.ErrClosed,
+		os.ErrDeadlineExceeded,
+		context.Canceled,
+		context.DeadlineExceeded,
+	)
+}
+
+// RegisterGobType function registers foreign error types for gob encoding.
+// Instances of registered types are restored as themselves by GobDecode method and DecodeGob function
+// (types must be encodable by encoding/gob package).
+// Values are registered by gob.Register function too.
+// Instances of unregistered types are restored as *RemoteError.
+func RegisterGobType(values ...error) {
+	gobRegistry.mu.Lock()
+	defer gobRegistry.mu.Unlock()
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		gob.Register(v)
+		gobRegistry.types[reflect.TypeOf(v)] = true
+	}
+}
+
+// RegisterGobSentinel function registers sentinel errors (e.g. io.EOF) for gob encoding.
+// Registered errors are restored as themselves by GobDecode method and DecodeGob function,
+// so errors.Is function works after gob round-trip.
+// Sentinel errors are identified by type name and message.
+// Standard sentinel errors of io, os and context packages are registered in advance.
+func RegisterGobSentinel(errlist ...error) {
+	gobRegistry.mu.Lock()
+	defer gobRegistry.mu.Unlock()
+	for _, err := range errlist {
+		if err == nil {
+			continue
+		}
+		gobRegistry.sentinels[sentinelKey(err)] = err
+	}
+}
+
+func sentinelKey(err error) string {
+	return TypeName(err) + "\x00" + err.Error()
+}
+
+// lookupSentinel returns registered sentinel error that is identical with err.
+func lookupSentinel(err error) bool {
+	gobRegistry.mu.RLock()
+	defer gobRegistry.mu.RUnlock()
+	s, ok := gobRegistry.sentinels[sentinelKey(err)]
+	if !ok || !reflect.TypeOf(err).Comparable() {
+		return false
+	}
+	return s == err
+}
+
+func isGobType(err error) bool {
+	gobRegistry.mu.RLock()
+	defer gobRegistry.mu.RUnlock()
+	return gobRegistry.types[reflect.TypeOf(err)]
+}
+
+// EncodeGob function returns gob data of error instance.
+// The data keeps error tree, the distinction between New and Wrap functions, and context values of *errs.Error.
+// It returns nil if err is nil.
+func EncodeGob(err error) ([]byte, error) {
+	if err == nil {
+		return nil, nil
+	}
+	return encodeGobNode(toGobNode(err))
+}
+
+// DecodeGob function restores error instance from gob data encoded by EncodeGob function.
+// *errs.Error, *errs.Errors, *errs.Collector, registered error types and sentinel errors are restored as themselves,
+// and other (foreign) error types are restored as *errs.RemoteError.
+// It returns nil if data is empty.
+func DecodeGob(data []byte) (error, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	node, err := decodeGobNode(data)
+	if err != nil {
+		return nil, err
+	}
+	return fromGobNode(node), nil
+}
+
+// GobEncode method returns gob data of Error instance.
+// This method is implementation of gob.GobEncoder interface.
+func (e *Error) GobEncode() ([]byte, error) {
+	if e == nil {
+		return nil, nil
+	}
+	return encodeGobNode(toGobNode(e))
+}
+
+// GobDecode method restores Error instance from gob data encoded by GobEncode method.
+// This method is implementation of gob.GobDecoder interface.
+func (e *Error) GobDecode(data []byte) error {
+	if e == nil {
+		return New("nil receiver")
+	}
+	node, err := decodeGobNode(data)
+	if err != nil {
+		return err
+	}
+	if node.Kind != gobKindError {
+		return New("type mismatch", WithContext("type", node.Type))
+	}
+	*e = *(fromGobNode(node).(*Error))
+	return nil
+}
+
+// GobEncode method returns gob data of Errors instance.
+// This method is implementation of gob.GobEncoder interface.
+func (es *Errors) GobEncode() ([]byte, error) {
+	if es == nil {
+		return nil, nil
+	}
+	return encodeGobNode(toGobNode(es))
+}
+
+// GobDecode method restores Errors instance from gob data encoded by GobEncode method.
+// This method is implementation of gob.GobDecoder interface.
+func (es *Errors) GobDecode(data []byte) error {
+	if es == nil {
+		return New("nil receiver")
+	}
+	node, err := decodeGobNode(data)
+	if err != nil {
+		return err
+	}
+	if node.Kind != gobKindErrors {
+		return New("type mismatch", WithContext("type", node.Type))
+	}
+	errlist := fromGobNodes(node.Children)
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	es.errs = errlist
+	es.head = 0
+	es.dropped = node.Dropped
+	es.restoreOccurs(node.Occurs)
+	return nil
+}
+
+// GobEncode method returns gob data of RemoteError instance.
+// This method is implementation of gob.GobEncoder interface.
+func (e *RemoteError) GobEncode() ([]byte, error) {
+	if e == nil {
+		return nil, nil
+	}
+	return encodeGobNode(toGobNode(e))
+}
+
+// GobDecode method restores RemoteError instance from gob data encoded by GobEncode method.
+// This method is implementation of gob.GobDecoder interface.
+func (e *RemoteError) GobDecode(data []byte) error {
+	if e == nil {
+		return New("nil receiver")
+	}
+	node, err := decodeGobNode(data)
+	if err != nil {
+		return err
+	}
+	*e = *newRemoteError(node)
+	return nil
+}
+
+func encodeGobNode(node *gobNode) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(node); err != nil {
+		return nil, Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeGobNode(data []byte) (*gobNode, error) {
+	node := &gobNode{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(node); err != nil {
+		return nil, Wrap(err)
+	}
+	return node, nil
+}
+
+func toGobNode(err error) *gobNode {
+	if err == nil {
+		return nil
+	}
+	switch e := err.(type) {
+	case *Error:
+		return &gobNode{
+			Kind:     gobKindError,
+			Type:     typeNameError,
+			Msg:      e.Error(),
+			WrapFlag: e.wrapFlag,
+			MsgFlag:  e.msgFlag,
+			Public:   e.public,
+			Err:      toGobNode(e.Err),
+			Cause:    toGobNode(e.Cause),
+			Context:  toGobContext(e.Context),
+		}
+	case *Errors:
+		return &gobNode{
+			Kind:     gobKindErrors,
+			Type:     typeNameErrors,
+			Msg:      e.Error(),
+			Children: toGobNodes(e.Unwrap()),
+			Dropped:  e.Dropped(),
+			Occurs:   e.occurrenceDataList(),
+		}
+	case *Collector:
+		return &gobNode{
+			Kind:     gobKindCollector,
+			Type:     typeNameCollector,
+			Msg:      e.Error(),
+			Children: toGobNodes(e.Unwrap()),
+		}
+	case *RemoteError:
+		return &gobNode{
+			Kind:     gobKindRemote,
+			Type:     e.Type,
+			Msg:      e.Msg,
+			Multi:    e.multi,
+			Children: toGobNodes(e.Causes),
+			Fields:   []byte(encodeFields(e.Fields)),
+		}
+	}
+	node := &gobNode{Kind: gobKindRemote, Type: TypeName(err), Msg: err.Error()}
+	if lookupSentinel(err) {
+		node.Kind = gobKindSentinel
+		return node
+	}
+	node.Fields = []byte(encodeFields(ExtraFields(err)))
+	if _, ok := unwrapMulti(err); ok {
+		node.Multi = true
+	}
+	node.Children = toGobNodes(Unwraps(err)) // for *RemoteError if the receiver can not restore the value
+	if isGobType(err) {
+		buf := &bytes.Buffer{}
+		if e := gob.NewEncoder(buf).Encode(&gobHolder{V: err}); e == nil {
+			node.Kind = gobKindValue
+			node.Value = buf.Bytes()
+		}
+	}
+	return node
+}
+
+func toGobNodes(errlist []error) []*gobNode {
+	if len(errlist) == 0 {
+		return nil
+	}
+	nodes := make([]*gobNode, 0, len(errlist))
+	for _, err := range errlist {
+		if err != nil {
+			nodes = append(nodes, toGobNode(err))
+		}
+	}
+	return nodes
+}
+
+func toGobContext(ctx map[string]interface{}) []gobContextValue {
+	if len(ctx) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(ctx))
+	for k := range ctx {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]gobContextValue, 0, len(keys))
+	for _, k := range keys {
+		cv := gobContextValue{Key: k}
+		buf := &bytes.Buffer{}
+		if err := gob.NewEncoder(buf).Encode(&gobHolder{V: ctx[k]}); err == nil {
+			cv.Gob = buf.Bytes()
+		} else if b, err := json.Marshal(ctx[k]); err == nil {
+			cv.JSON = b
+		} else {
+			cv.JSON, _ = json.Marshal(contextValueString(ctx[k]))
+		}
+		values = append(values, cv)
+	}
+	return values
+}
+
+func fromGobNode(node *gobNode) error {
+	if node == nil {
+		return nil
+	}
+	switch node.Kind {
+	case gobKindError:
+		return &Error{
+			wrapFlag: node.WrapFlag,
+			msgFlag:  node.MsgFlag,
+			public:   node.Public,
+			Err:      fromGobNode(node.Err),
+			Cause:    fromGobNode(node.Cause),
+			Context:  fromGobContext(node.Context),
+		}
+	case gobKindErrors:
+		es := &Errors{errs: fromGobNodes(node.Children), dropped: node.Dropped}
+		es.restoreOccurs(node.Occurs)
+		return es
+	case gobKindCollector:
+		return NewCollector(fromGobNodes(node.Children)...)
+	case gobKindSentinel:
+		gobRegistry.mu.RLock()
+		s, ok := gobRegistry.sentinels[node.Type+"\x00"+node.Msg]
+		gobRegistry.mu.RUnlock()
+		if ok {
+			return s
+		}
+	case gobKindValue:
+		h := &gobHolder{}
+		if err := gob.NewDecoder(bytes.NewReader(node.Value)).Decode(h); err == nil {
+			if e, ok := h.V.(error); ok {
+				return e
+			}
+		}
+	}
+	return newRemoteError(node)
+}
+
+func fromGobNodes(nodes []*gobNode) []error {
+	errlist := make([]error, 0, len(nodes))
+	for _, node := range nodes {
+		if err := fromGobNode(node); err != nil {
+			errlist = append(errlist, err)
+		}
+	}
+	return errlist
+}
+
+func newRemoteError(node *gobNode) *RemoteError {
+	e := &RemoteError{Type: node.Type, Msg: node.Msg, multi: node.Multi}
+	if len(node.Fields) > 0 {
+		_ = json.Unmarshal(node.Fields, &e.Fields)
+	}
+	if len(node.Children) > 0 {
+		e.Causes = fromGobNodes(node.Children)
+	}
+	return e
+}
+
+func fromGobContext(values []gobContextValue) map[string]interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	ctx := make(map[string]interface{}, len(values))
+	for _, cv := range values {
+		switch {
+		case len(cv.Gob) > 0:
+			h := &gobHolder{}
+			if err := gob.NewDecoder(bytes.NewReader(cv.Gob)).Decode(h); err == nil {
+				ctx[cv.Key] = h.V
+				continue
+			}
+			ctx[cv.Key] = nil
+		case len(cv.JSON) > 0:
+			var v interface{}
+			if err := json.Unmarshal(cv.JSON, &v); err == nil {
+				ctx[cv.Key] = v
+				continue
+			}
+			ctx[cv.Key] = string(cv.JSON)
+		default:
+			ctx[cv.Key] = nil
+		}
+	}
+	return ctx
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
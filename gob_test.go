@@ -0,0 +1,206 @@
+package errs
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"io"
+	"os"
+	"syscall"
+	"testing"
+)
+
+type gobTestError struct {
+	Code int
+	Msg  string
+}
+
+func (e *gobTestError) Error() string { return e.Msg }
+
+type gobTestPoint struct {
+	X, Y int
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	RegisterGobType(&gobTestError{})
+	testCases := []struct {
+		err error
+	}{
+		{err: errTest},
+		{err: wrapedErrTest},
+		{err: New("wrapped message", WithCause(os.ErrInvalid), WithContext("foo", "bar"), WithContext("num", 1))},
+		{err: Wrap(os.ErrInvalid, WithCause(errors.Join(io.EOF, io.ErrUnexpectedEOF)))},
+		{err: Join(os.ErrInvalid, Wrap(io.EOF))},
+		{err: Wrap(&os.PathError{Op: "open", Path: "not-exist.txt", Err: os.ErrNotExist})},
+		{err: Wrap(&gobTestError{Code: 42, Msg: "custom"}, WithContext("point", gobTestPoint{X: 1, Y: 2}))},
+		{err: Errorf("wrap %w and %w", io.EOF, os.ErrClosed)},
+		{err: Wrap(syscall.ENOENT)},
+		{err: New("internal detail", WithPublicMessage("bad request"))},
+	}
+
+	for _, tc := range testCases {
+		data, e := EncodeGob(tc.err)
+		if e != nil {
+			t.Errorf("EncodeGob(%v) is \"%v\", want <nil>", tc.err, e)
+			continue
+		}
+		err, e := DecodeGob(data)
+		if e != nil {
+			t.Errorf("DecodeGob(%v) is \"%v\", want <nil>", tc.err, e)
+			continue
+		}
+		if got, want := err.Error(), tc.err.Error(); got != want {
+			t.Errorf("DecodeGob(%v).Error() is %v, want %v", tc.err, got, want)
+		}
+		if got, want := EncodeJSON(err), EncodeJSON(tc.err); got != want {
+			t.Errorf("EncodeJSON(DecodeGob(%v)) is %v, want %v", tc.err, got, want)
+		}
+		if !Equal(err, tc.err) {
+			t.Errorf("Equal(DecodeGob(%v), %v) is false, want true: %v", tc.err, tc.err, Diff(err, tc.err))
+		}
+	}
+}
+
+func TestGobWrapFlag(t *testing.T) {
+	testCases := []struct {
+		err    error
+		unwrap bool
+	}{
+		{err: New("message"), unwrap: false},
+		{err: Wrap(errors.New("message")), unwrap: true},
+	}
+
+	for _, tc := range testCases {
+		data, e := EncodeGob(tc.err)
+		if e != nil {
+			t.Errorf("EncodeGob(%v) is \"%v\", want <nil>", tc.err, e)
+			continue
+		}
+		err, e := DecodeGob(data)
+		if e != nil {
+			t.Errorf("DecodeGob(%v) is \"%v\", want <nil>", tc.err, e)
+			continue
+		}
+		if got := errors.Unwrap(err) != nil; got != tc.unwrap {
+			t.Errorf("errors.Unwrap(DecodeGob(%v)) != nil is %v, want %v", tc.err, got, tc.unwrap)
+		}
+	}
+}
+
+func TestGobRegistered(t *testing.T) {
+	RegisterGobType(&gobTestError{})
+	err := Join(Wrap(&gobTestError{Code: 42, Msg: "custom"}), io.EOF)
+	data, e := EncodeGob(err)
+	if e != nil {
+		t.Fatalf("EncodeGob(%v) is \"%v\", want <nil>", err, e)
+	}
+	res, e := DecodeGob(data)
+	if e != nil {
+		t.Fatalf("DecodeGob(%v) is \"%v\", want <nil>", err, e)
+	}
+	var target *gobTestError
+	if !errors.As(res, &target) {
+		t.Errorf("errors.As(%v) is false, want true", res)
+	} else if target.Code != 42 {
+		t.Errorf("gobTestError.Code is %v, want %v", target.Code, 42)
+	}
+	if !errors.Is(res, io.EOF) {
+		t.Errorf("errors.Is(%v, io.EOF) is false, want true", res)
+	}
+	res, _ = DecodeGob(mustEncodeGob(t, Wrap(syscall.ENOENT)))
+	if !errors.Is(res, os.ErrNotExist) {
+		t.Errorf("errors.Is(%v, os.ErrNotExist) is false, want true", res)
+	}
+	var pe *os.PathError
+	res, _ = DecodeGob(mustEncodeGob(t, Wrap(&os.PathError{Op: "open", Path: "x", Err: os.ErrNotExist})))
+	if errors.As(res, &pe) {
+		t.Errorf("errors.As(%v, *os.PathError) is true, want false", res)
+	}
+	var re *RemoteError
+	if !errors.As(res, &re) || re.Type != "*fs.PathError" {
+		t.Errorf("errors.As(%v, *RemoteError) is false, want true", res)
+	}
+	if !errors.Is(res, os.ErrNotExist) {
+		t.Errorf("errors.Is(%v, os.ErrNotExist) is false, want true", res)
+	}
+}
+
+func TestGobContext(t *testing.T) {
+	err := New("message", WithContext("int", 1), WithContext("string", "foo"), WithContext("point", gobTestPoint{X: 1, Y: 2}), WithContext("func", func() {}))
+	res, e := DecodeGob(mustEncodeGob(t, err))
+	if e != nil {
+		t.Fatalf("DecodeGob(%v) is \"%v\", want <nil>", err, e)
+	}
+	ctx := res.(*Error).Context
+	if got, want := ctx["int"], 1; got != want {
+		t.Errorf("Context[int] is %#v, want %#v", got, want)
+	}
+	if got, want := ctx["string"], "foo"; got != want {
+		t.Errorf("Context[string] is %#v, want %#v", got, want)
+	}
+	if got, want := contextValueString(ctx["point"]), `{"X":1,"Y":2}`; got != want {
+		t.Errorf("Context[point] is %v, want %v", got, want)
+	}
+	if _, ok := ctx["func"]; !ok {
+		t.Error("Context[func] is not found, want it")
+	}
+}
+
+func TestGobInterfaceField(t *testing.T) {
+	type reply struct {
+		Err error
+	}
+	in := reply{Err: Wrap(io.EOF, WithContext("foo", "bar"))}
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(&in); err != nil {
+		t.Fatalf("gob.Encode() is \"%v\", want <nil>", err)
+	}
+	out := reply{}
+	if err := gob.NewDecoder(buf).Decode(&out); err != nil {
+		t.Fatalf("gob.Decode() is \"%v\", want <nil>", err)
+	}
+	if got, want := EncodeJSON(out.Err), EncodeJSON(in.Err); got != want {
+		t.Errorf("EncodeJSON(reply.Err) is %v, want %v", got, want)
+	}
+	if !errors.Is(out.Err, io.EOF) {
+		t.Errorf("errors.Is(%v, io.EOF) is false, want true", out.Err)
+	}
+}
+
+func TestGobDecodeTypeMismatch(t *testing.T) {
+	e := &Error{}
+	if err := e.GobDecode(mustEncodeGob(t, Join(io.EOF))); err == nil {
+		t.Error("Error.GobDecode(Errors) is <nil>, want error")
+	}
+	es := &Errors{}
+	if err := es.GobDecode(mustEncodeGob(t, New("message"))); err == nil {
+		t.Error("Errors.GobDecode(Error) is <nil>, want error")
+	}
+	if _, err := DecodeGob([]byte("invalid")); err == nil {
+		t.Error("DecodeGob(invalid) is <nil>, want error")
+	}
+}
+
+func mustEncodeGob(t *testing.T, err error) []byte {
+	t.Helper()
+	data, e := EncodeGob(err)
+	if e != nil {
+		t.Fatalf("EncodeGob(%v) is \"%v\", want <nil>", err, e)
+	}
+	return data
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
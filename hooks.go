@@ -0,0 +1,109 @@
+package errs
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// hookEntry is a registered hook function.
+type hookEntry[T any] struct {
+	id uint64
+	fn func(T)
+}
+
+// hookRegistry is copy-on-write registry of hook functions.
+// Registration is serialized by mutex, and calls read the snapshot without lock.
+type hookRegistry[T any] struct {
+	mu   sync.Mutex
+	seq  uint64
+	list atomic.Value // []hookEntry[T]
+}
+
+var (
+	errorHooks  = &hookRegistry[*Error]{}
+	errorsHooks = &hookRegistry[*Errors]{}
+)
+
+// RegisterHook function registers hook function called when *Error instance is created
+// by New, Wrap, Errorf functions and so on (after all ErrorContextFunc options are applied).
+// Hooks are called synchronously in registration order, so they should be cheap (e.g. counting metrics).
+// A panic in hook is recovered and ignored, and does not affect other hooks and the caller.
+// Hooks must not modify the error instance, and must not create errors of errs package (that calls hooks recursively).
+// It is safe to register and unregister hooks at any time from any goroutine.
+// It returns function to unregister the hook.
+func RegisterHook(fn func(*Error)) func() {
+	return errorHooks.add(fn)
+}
+
+// RegisterErrorsHook function registers hook function called when *Errors instance is created by Join and JoinFlat functions.
+// It is not called for empty *Errors instance (e.g. NewErrors function), that is not error yet.
+// Hooks are called in the same manner as RegisterHook function.
+// It returns function to unregister the hook.
+func RegisterErrorsHook(fn func(*Errors)) func() {
+	return errorsHooks.add(fn)
+}
+
+func (r *hookRegistry[T]) add(fn func(T)) func() {
+	if fn == nil {
+		return func() {}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	id := r.seq
+	old := r.load()
+	list := make([]hookEntry[T], 0, len(old)+1)
+	list = append(list, old...)
+	r.list.Store(append(list, hookEntry[T]{id: id, fn: fn}))
+	var once sync.Once
+	return func() {
+		once.Do(func() { r.remove(id) })
+	}
+}
+
+func (r *hookRegistry[T]) remove(id uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old := r.load()
+	list := make([]hookEntry[T], 0, len(old))
+	for _, h := range old {
+		if h.id != id {
+			list = append(list, h)
+		}
+	}
+	r.list.Store(list)
+}
+
+func (r *hookRegistry[T]) load() []hookEntry[T] {
+	list, _ := r.list.Load().([]hookEntry[T])
+	return list
+}
+
+// call calls all hook functions with v.
+func (r *hookRegistry[T]) call(v T) {
+	for _, h := range r.load() {
+		callHook(h.fn, v)
+	}
+}
+
+func callHook[T any](fn func(T), v T) {
+	defer func() {
+		_ = recover()
+	}()
+	fn(v)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
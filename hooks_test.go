@@ -0,0 +1,107 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"testing"
+)
+
+func TestRegisterHook(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	record := func(name string) func(*Error) {
+		return func(e *Error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls = append(calls, name+":"+e.Error())
+		}
+	}
+	unregister1 := RegisterHook(record("1"))
+	unregister2 := RegisterHook(func(e *Error) { panic("hook panic") })
+	unregister3 := RegisterHook(record("3"))
+
+	_ = New("new")
+	_ = Wrap(io.EOF, WithContext("foo", "bar"))
+	_ = Errorf("errorf %w", io.EOF)
+	unregister1()
+	unregister1()
+	_ = New("after")
+	unregister2()
+	unregister3()
+	_ = New("none")
+
+	want := []string{"1:new", "3:new", "1:EOF", "3:EOF", "1:errorf EOF", "3:errorf EOF", "3:after"}
+	if len(calls) != len(want) {
+		t.Fatalf("hook calls are %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("hook call[%d] is %v, want %v", i, calls[i], want[i])
+		}
+	}
+}
+
+func TestRegisterHookContext(t *testing.T) {
+	var got *Error
+	defer RegisterHook(func(e *Error) { got = e })()
+	err := New("message", WithContext("code", 42))
+	if got != err {
+		t.Fatalf("hook is called with %v, want %v", got, err)
+	}
+	if got.Context["code"] != 42 || got.Context["function"] != "github.com/goark/errs.TestRegisterHookContext" {
+		t.Errorf("hook is called with context %v, want code and function", got.Context)
+	}
+}
+
+func TestRegisterErrorsHook(t *testing.T) {
+	count := 0
+	unregister := RegisterErrorsHook(func(es *Errors) {
+		count += len(es.Unwrap())
+	})
+	_ = Join(io.EOF, nil, io.ErrUnexpectedEOF)
+	_ = Join(nil)
+	_ = NewErrors(WithCapacity(1, KeepFirst))
+	_ = JoinFlat(io.EOF, errors.Join(io.ErrUnexpectedEOF))
+	unregister()
+	_ = Join(io.EOF)
+	if count != 4 {
+		t.Errorf("count of errors in hook is %v, want %v", count, 4)
+	}
+}
+
+func TestRegisterHookConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				unregister := RegisterHook(func(e *Error) {})
+				_ = New("message")
+				unregister()
+			}
+		}()
+	}
+	wg.Wait()
+	if n := len(errorHooks.load()); n != 0 {
+		t.Errorf("count of hooks is %v, want %v", n, 0)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,366 @@
+// Package httpmw implements net/http middleware that recovers panics and writes error responses for errs package.
+package httpmw
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+// DefaultRequestIDHeader is the default header name of request ID.
+const DefaultRequestIDHeader = "X-Request-Id"
+
+// Mode type is mode of error response body.
+type Mode int
+
+const (
+	// Production mode writes minimal response body (status, public message and request ID).
+	// If error tree has no public message, status text is used for 4xx status.
+	Production Mode = iota
+	// Development mode writes response body with whole error tree (JSON data by MarshalJSON method).
+	Development
+)
+
+// Sink is an interface for reporting errors in HTTP handlers (e.g. logging).
+type Sink interface {
+	Report(r *http.Request, status int, err error)
+}
+
+// SinkFunc type is an adapter to use ordinary function as Sink.
+type SinkFunc func(r *http.Request, status int, err error)
+
+var _ Sink = SinkFunc(nil) //SinkFunc type is compatible with Sink interface
+
+// Report method calls f(r, status, err).
+func (f SinkFunc) Report(r *http.Request, status int, err error) {
+	f(r, status, err)
+}
+
+// LoggerSink function returns Sink that writes errors with JSON format to logger.
+// If logger is nil, log.Default() is used.
+func LoggerSink(logger *log.Logger) Sink {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return SinkFunc(func(r *http.Request, status int, err error) {
+		logger.Printf("%d %s %s: %s", status, r.Method, r.URL.Path, errs.EncodeJSON(err))
+	})
+}
+
+// HandlerFunc type is HTTP handler function that returns error.
+// Use Middleware.Handle method to make http.Handler.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// Option type is self-referential function type for New function. (functional options pattern)
+type Option func(*Middleware)
+
+// WithMode function returns Option function value.
+// This function sets mode of error response body (default is Production).
+func WithMode(mode Mode) Option {
+	return func(m *Middleware) {
+		m.mode = mode
+	}
+}
+
+// WithSink function returns Option function value.
+// This function sets Sink instance (default is LoggerSink(nil)).
+func WithSink(sink Sink) Option {
+	return func(m *Middleware) {
+		if sink != nil {
+			m.sink = sink
+		}
+	}
+}
+
+// WithRequestIDHeader function returns Option function value.
+// This function sets header name of request ID (default is DefaultRequestIDHeader).
+func WithRequestIDHeader(name string) Option {
+	return func(m *Middleware) {
+		if len(name) > 0 {
+			m.requestIDHeader = name
+		}
+	}
+}
+
+// WithStatus function returns errs.ErrorContextFunc function value.
+// This function is used in errs.New and errs.Wrap functions that represents HTTP status code of error response.
+func WithStatus(status int) errs.ErrorContextFunc {
+	return errs.WithContext("status", status)
+}
+
+// Middleware is a set of net/http middleware for errs package.
+type Middleware struct {
+	mode            Mode
+	sink            Sink
+	requestIDHeader string
+}
+
+// New function returns Middleware instance.
+func New(opts ...Option) *Middleware {
+	m := &Middleware{
+		mode:            Production,
+		sink:            LoggerSink(nil),
+		requestIDHeader: DefaultRequestIDHeader,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Recover method returns http.Handler that recovers panics in next handler.
+// The panic value is converted to *errs.Error instance with request method, path, request ID and stack trace as context,
+// reported to Sink, and written as error response.
+// http.ErrAbortHandler is re-panicked.
+func (m *Middleware) Recover(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &responseWriter{ResponseWriter: w}
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if e, ok := v.(error); ok && e == http.ErrAbortHandler {
+				panic(v)
+			}
+			m.Error(rw, r, panicError(v))
+		}()
+		next.ServeHTTP(rw.wrap(), r)
+	})
+}
+
+// Handle method returns http.Handler from HandlerFunc.
+// Error returned by fn is written as error response (see Error method). Panics in fn are recovered too.
+func (m *Middleware) Handle(fn HandlerFunc) http.Handler {
+	return m.Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := fn(w, r); err != nil {
+			m.Error(w, r, err)
+		}
+	}))
+}
+
+// Error method reports error to Sink and writes error response.
+// Request method, path and request ID are attached to error as context.
+// Status code of the response is "status" context value in error tree (see StatusCode function).
+// If the response has been already written, only reporting is done.
+func (m *Middleware) Error(w http.ResponseWriter, r *http.Request, err error) {
+	if err == nil {
+		return
+	}
+	reqID := r.Header.Get(m.requestIDHeader)
+	opts := []errs.ErrorContextFunc{
+		errs.WithContext("method", r.Method),
+		errs.WithContext("path", r.URL.Path),
+	}
+	if len(reqID) > 0 {
+		opts = append(opts, errs.WithContext("request_id", reqID))
+	}
+	err = errs.Wrap(err, opts...)
+	status := StatusCode(err)
+	m.sink.Report(r, status, err)
+	if rw, ok := w.(interface{ written() bool }); ok && rw.written() {
+		return
+	}
+	if len(reqID) > 0 {
+		w.Header().Set(m.requestIDHeader, reqID)
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(m.body(status, reqID, err)))
+}
+
+// body returns JSON data of error response.
+func (m *Middleware) body(status int, reqID string, err error) string {
+	elms := []string{strings.Join([]string{`"status":`, strconv.Itoa(status)}, "")}
+	if m.mode == Development {
+		b, e := json.Marshal(err)
+		if e != nil {
+			b = []byte(errs.EncodeJSON(err))
+		}
+		elms = append(elms, strings.Join([]string{`"error":`, string(b)}, ""))
+	} else {
+		msg := errs.PublicMessage(err)
+		if msg == errs.DefaultPublicMessage && status < http.StatusInternalServerError {
+			msg = http.StatusText(status)
+		}
+		b, _ := json.Marshal(msg)
+		elms = append(elms, strings.Join([]string{`"message":`, string(b)}, ""))
+	}
+	if len(reqID) > 0 {
+		b, _ := json.Marshal(reqID)
+		elms = append(elms, strings.Join([]string{`"request_id":`, string(b)}, ""))
+	}
+	return strings.Join([]string{"{", strings.Join(elms, ","), "}\n"}, "")
+}
+
+// StatusCode function returns the outermost "status" context value (see WithStatus function) in error tree.
+// If error tree has no valid status code, it returns http.StatusInternalServerError.
+func StatusCode(err error) int {
+	status := 0
+	if errs.FindOutermost(err, func(e error) bool {
+		ee, ok := e.(*errs.Error)
+		if !ok || ee == nil {
+			return false
+		}
+		var found bool
+		status, found = statusOf(ee.Context["status"])
+		return found
+	}) == nil {
+		return http.StatusInternalServerError
+	}
+	return status
+}
+
+// statusOf returns HTTP status code from context value (also JSON number after errs.DecodeJSON function).
+func statusOf(v interface{}) (int, bool) {
+	var status int
+	switch s := v.(type) {
+	case int:
+		status = s
+	case int64:
+		status = int(s)
+	case float64:
+		status = int(s)
+	case json.Number:
+		i, err := s.Int64()
+		if err != nil {
+			return 0, false
+		}
+		status = int(i)
+	default:
+		return 0, false
+	}
+	if status < 400 || status > 599 {
+		return 0, false
+	}
+	return status, true
+}
+
+// panicError returns error instance from recovered value.
+// If the value is formatted to empty string (e.g. panic("")), "panic" is used as error message.
+func panicError(v interface{}) error {
+	opts := []errs.ErrorContextFunc{
+		errs.WithContext("panic", true),
+		errs.WithContext("stack", string(debug.Stack())),
+	}
+	if e, ok := v.(error); ok {
+		return errs.Wrap(e, opts...)
+	}
+	msg := fmt.Sprint(v)
+	if len(msg) == 0 {
+		msg = "panic"
+	}
+	return errs.New(msg, opts...)
+}
+
+// responseWriter is http.ResponseWriter that records whether header has been written.
+// http.Hijacker, http.Pusher and io.ReaderFrom interfaces are forwarded to the original http.ResponseWriter
+// (http.ErrNotSupported is returned if it does not support them).
+type responseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+var (
+	_ http.Hijacker = (*responseWriter)(nil)
+	_ http.Pusher   = (*responseWriter)(nil)
+	_ io.ReaderFrom = (*responseWriter)(nil)
+	_ http.Flusher  = flushResponseWriter{}
+)
+
+// wrap returns http.ResponseWriter passed to handler.
+// It implements http.Flusher interface only if the original http.ResponseWriter does.
+func (w *responseWriter) wrap() http.ResponseWriter {
+	if _, ok := w.ResponseWriter.(http.Flusher); ok {
+		return flushResponseWriter{w}
+	}
+	return w
+}
+
+// written reports whether header has been written (or connection has been hijacked).
+func (w *responseWriter) written() bool {
+	return w.wroteHeader
+}
+
+// WriteHeader method is implementation of http.ResponseWriter interface.
+func (w *responseWriter) WriteHeader(status int) {
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write method is implementation of http.ResponseWriter interface.
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// ReadFrom method is implementation of io.ReaderFrom interface.
+func (w *responseWriter) ReadFrom(r io.Reader) (int64, error) {
+	w.wroteHeader = true
+	if rf, ok := w.ResponseWriter.(io.ReaderFrom); ok {
+		return rf.ReadFrom(r)
+	}
+	return io.Copy(struct{ io.Writer }{w.ResponseWriter}, r)
+}
+
+// Hijack method is implementation of http.Hijacker interface.
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errs.Wrap(http.ErrNotSupported)
+	}
+	conn, rw, err := h.Hijack()
+	if err == nil {
+		w.wroteHeader = true
+	}
+	return conn, rw, err
+}
+
+// Push method is implementation of http.Pusher interface.
+func (w *responseWriter) Push(target string, opts *http.PushOptions) error {
+	if p, ok := w.ResponseWriter.(http.Pusher); ok {
+		return p.Push(target, opts)
+	}
+	return errs.Wrap(http.ErrNotSupported)
+}
+
+// Unwrap method returns original http.ResponseWriter (used by http.ResponseController).
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// flushResponseWriter is responseWriter that implements http.Flusher interface.
+type flushResponseWriter struct {
+	*responseWriter
+}
+
+// Flush method is implementation of http.Flusher interface.
+func (w flushResponseWriter) Flush() {
+	w.wroteHeader = true
+	w.ResponseWriter.(http.Flusher).Flush()
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,263 @@
+package httpmw_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/httpmw"
+)
+
+type report struct {
+	status int
+	err    error
+}
+
+func newMiddleware(mode httpmw.Mode) (*httpmw.Middleware, *[]report) {
+	reports := &[]report{}
+	return httpmw.New(
+		httpmw.WithMode(mode),
+		httpmw.WithSink(httpmw.SinkFunc(func(r *http.Request, status int, err error) {
+			*reports = append(*reports, report{status: status, err: err})
+		})),
+	), reports
+}
+
+func TestRecover(t *testing.T) {
+	testCases := []struct {
+		v       interface{}
+		mode    httpmw.Mode
+		status  int
+		message string
+	}{
+		{v: "boom", mode: httpmw.Production, status: http.StatusInternalServerError, message: `"message":"internal error"`},
+		{v: errs.New("boom", httpmw.WithStatus(http.StatusServiceUnavailable), errs.WithPublicMessage("try later")), mode: httpmw.Production, status: http.StatusServiceUnavailable, message: `"message":"try later"`},
+		{v: os.ErrInvalid, mode: httpmw.Development, status: http.StatusInternalServerError, message: `"Msg":"invalid argument"`},
+		{v: "", mode: httpmw.Production, status: http.StatusInternalServerError, message: `"message":"internal error"`},
+		{v: "", mode: httpmw.Development, status: http.StatusInternalServerError, message: `"Msg":"panic"`},
+	}
+
+	for _, tc := range testCases {
+		m, reports := newMiddleware(tc.mode)
+		h := m.Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(tc.v)
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/foo", nil)
+		req.Header.Set(httpmw.DefaultRequestIDHeader, "req-1")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		if rec.Code != tc.status {
+			t.Errorf("status of panic(%v) is %v, want %v", tc.v, rec.Code, tc.status)
+		}
+		body := rec.Body.String()
+		if !json.Valid([]byte(body)) {
+			t.Errorf("body of panic(%v) is invalid JSON: %v", tc.v, body)
+		}
+		for _, want := range []string{tc.message, `"request_id":"req-1"`} {
+			if !strings.Contains(body, want) {
+				t.Errorf("body of panic(%v) is %v, want to contain %v", tc.v, body, want)
+			}
+		}
+		if got := rec.Header().Get(httpmw.DefaultRequestIDHeader); got != "req-1" {
+			t.Errorf("request ID header is %v, want %v", got, "req-1")
+		}
+		if len(*reports) != 1 {
+			t.Errorf("count of reports is %v, want %v", len(*reports), 1)
+			continue
+		}
+		rep := (*reports)[0]
+		if rep.status != tc.status {
+			t.Errorf("reported status is %v, want %v", rep.status, tc.status)
+		}
+		var e *errs.Error
+		if !errors.As(rep.err, &e) {
+			t.Errorf("reported error is %T, want *errs.Error", rep.err)
+			continue
+		}
+		for k, v := range map[string]interface{}{"method": http.MethodGet, "path": "/foo", "request_id": "req-1"} {
+			if got := e.Context[k]; got != v {
+				t.Errorf("Context[%v] is %v, want %v", k, got, v)
+			}
+		}
+		if !strings.Contains(errs.EncodeJSON(rep.err), `"panic":true`) {
+			t.Errorf("reported error is %v, want panic context", errs.EncodeJSON(rep.err))
+		}
+	}
+}
+
+func TestRecoverAbortHandler(t *testing.T) {
+	m, _ := newMiddleware(httpmw.Production)
+	h := m.Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	defer func() {
+		if v := recover(); v != http.ErrAbortHandler {
+			t.Errorf("recover() is %v, want %v", v, http.ErrAbortHandler)
+		}
+	}()
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
+
+func TestHandle(t *testing.T) {
+	testCases := []struct {
+		fn     httpmw.HandlerFunc
+		status int
+		body   string
+		count  int
+	}{
+		{
+			fn: func(w http.ResponseWriter, r *http.Request) error {
+				_, err := w.Write([]byte("ok"))
+				return err
+			},
+			status: http.StatusOK,
+			body:   "ok",
+			count:  0,
+		},
+		{
+			fn: func(w http.ResponseWriter, r *http.Request) error {
+				return errs.Wrap(os.ErrNotExist, httpmw.WithStatus(http.StatusNotFound))
+			},
+			status: http.StatusNotFound,
+			body:   `{"status":404,"message":"Not Found"}` + "\n",
+			count:  1,
+		},
+		{
+			fn: func(w http.ResponseWriter, r *http.Request) error {
+				w.WriteHeader(http.StatusAccepted)
+				return errs.New("after header")
+			},
+			status: http.StatusAccepted,
+			body:   "",
+			count:  1,
+		},
+	}
+
+	for i, tc := range testCases {
+		m, reports := newMiddleware(httpmw.Production)
+		rec := httptest.NewRecorder()
+		m.Handle(tc.fn).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/bar", nil))
+		if rec.Code != tc.status {
+			t.Errorf("status of case %d is %v, want %v", i, rec.Code, tc.status)
+		}
+		if got := rec.Body.String(); got != tc.body {
+			t.Errorf("body of case %d is %v, want %v", i, got, tc.body)
+		}
+		if len(*reports) != tc.count {
+			t.Errorf("count of reports of case %d is %v, want %v", i, len(*reports), tc.count)
+		}
+	}
+}
+
+func TestHijack(t *testing.T) {
+	reports := make(chan error, 1)
+	m := httpmw.New(httpmw.WithSink(httpmw.SinkFunc(func(r *http.Request, status int, err error) {
+		reports <- err
+	})))
+	srv := httptest.NewServer(m.Handle(func(w http.ResponseWriter, r *http.Request) error {
+		hj, ok := w.(http.Hijacker)
+		if !ok {
+			return errs.New("http.Hijacker is not implemented")
+		}
+		conn, buf, err := hj.Hijack()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		defer conn.Close()
+		_, _ = buf.WriteString("HTTP/1.1 200 OK\r\nContent-Length: 8\r\nConnection: close\r\n\r\nhijacked")
+		_ = buf.Flush()
+		return errs.New("error after hijack") // reported, but response is not written
+	}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("http.Get() is \"%v\", want <nil>", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(bufio.NewReader(resp.Body))
+	if resp.StatusCode != http.StatusOK || string(body) != "hijacked" {
+		t.Errorf("response is %v %q, want %v %q", resp.StatusCode, body, http.StatusOK, "hijacked")
+	}
+	if err := <-reports; err == nil || !strings.Contains(err.Error(), "error after hijack") {
+		t.Errorf("reported error is \"%v\", want \"error after hijack\"", err)
+	}
+}
+
+// plainWriter is http.ResponseWriter that implements no optional interfaces.
+type plainWriter struct {
+	header http.Header
+	body   strings.Builder
+}
+
+func (w *plainWriter) Header() http.Header         { return w.header }
+func (w *plainWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+func (w *plainWriter) WriteHeader(int)             {}
+
+func TestOptionalInterfaces(t *testing.T) {
+	m, _ := newMiddleware(httpmw.Production)
+	testCases := []struct {
+		w     http.ResponseWriter
+		flush bool
+	}{
+		{w: httptest.NewRecorder(), flush: true},
+		{w: &plainWriter{header: http.Header{}}, flush: false},
+	}
+
+	for _, tc := range testCases {
+		m.Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := w.(http.Flusher); ok != tc.flush {
+				t.Errorf("http.Flusher of %T is %v, want %v", tc.w, ok, tc.flush)
+			}
+			if _, _, err := w.(http.Hijacker).Hijack(); !errors.Is(err, http.ErrNotSupported) {
+				t.Errorf("Hijack() of %T is \"%v\", want \"%v\"", tc.w, err, http.ErrNotSupported)
+			}
+			if n, err := w.(io.ReaderFrom).ReadFrom(strings.NewReader("body")); n != 4 || err != nil {
+				t.Errorf("ReadFrom() of %T is %v, \"%v\", want %v, <nil>", tc.w, n, err, 4)
+			}
+		})).ServeHTTP(tc.w, httptest.NewRequest(http.MethodGet, "/", nil))
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	testCases := []struct {
+		err    error
+		status int
+	}{
+		{err: nil, status: http.StatusInternalServerError},
+		{err: os.ErrInvalid, status: http.StatusInternalServerError},
+		{err: errs.New("bad", httpmw.WithStatus(http.StatusBadRequest)), status: http.StatusBadRequest},
+		{err: errs.Wrap(errs.New("bad", httpmw.WithStatus(http.StatusBadRequest)), httpmw.WithStatus(http.StatusConflict)), status: http.StatusConflict},
+		{err: errs.Join(os.ErrInvalid, errs.New("bad", httpmw.WithStatus(http.StatusBadRequest))), status: http.StatusBadRequest},
+		{err: errs.New("ok", httpmw.WithStatus(http.StatusOK)), status: http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		if got := httpmw.StatusCode(tc.err); got != tc.status {
+			t.Errorf("StatusCode(%v) is %v, want %v", tc.err, got, tc.status)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
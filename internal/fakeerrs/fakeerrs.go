@@ -0,0 +1,32 @@
+// Package errs (in internal/fakeerrs directory) is a foreign package that has the same package name as errs package.
+// It is used in tests that compare error types with the same type name but different package paths.
+package errs
+
+// Error type is a foreign error type named "*errs.Error".
+type Error struct {
+	Msg string
+}
+
+// Error method returns error message.
+// This method is a implementation of error interface.
+func (e *Error) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	return e.Msg
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,161 @@
+package errs
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// maxStackDepth is maximum number of frames captured by WithStack function.
+const maxStackDepth = 64
+
+// WithStack function returns ErrorContextFunc function value.
+// This function is used in New and Wrap functions that captures stack trace as "stack" context value.
+// The stack trace is []string of "function file:line" (the caller of New/Wrap function is first).
+func WithStack() ErrorContextFunc {
+	return func(e *Error) {
+		if e == nil {
+			return
+		}
+		if e.depth > 0 {
+			e.stack = true // captured in newError function after all options are applied
+			return
+		}
+		_ = e.SetContext("stack", callers(1, true))
+	}
+}
+
+// WithCallerSkip function returns ErrorContextFunc function value.
+// This function is used in New and Wrap functions called from helper functions,
+// that skips skip frames of the caller: "function" context value is set to the caller of the helper function.
+// WithStack option captures stack trace from the same frame, regardless of the order of options.
+// It does nothing if it is applied out of New and Wrap functions.
+func WithCallerSkip(skip int) ErrorContextFunc {
+	return func(e *Error) {
+		if e == nil || e.depth == 0 || skip <= 0 {
+			return
+		}
+		e.skip += skip
+	}
+}
+
+// callers returns stack trace of the caller of callers function (skip frames are skipped).
+// If trim is true, leading frames in errs package and runtime are skipped too.
+func callers(skip int, trim bool) []string {
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip+2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	stack := make([]string, 0, n)
+	for {
+		frame, more := frames.Next()
+		if trim && isInternalFrame(frame) {
+			if !more {
+				break
+			}
+			continue
+		}
+		trim = false
+		stack = append(stack, strings.Join([]string{frame.Function, " ", frame.File, ":", strconv.Itoa(frame.Line)}, ""))
+		if !more {
+			break
+		}
+	}
+	return stack
+}
+
+// isInternalFrame reports whether the frame is in errs package (except for test code) or runtime.
+func isInternalFrame(frame runtime.Frame) bool {
+	if strings.HasPrefix(frame.Function, "runtime.") {
+		return true
+	}
+	return strings.HasPrefix(frame.Function, "github.com/goark/errs.") && !strings.HasSuffix(frame.File, "_test.go")
+}
+
+// checkPanic is panic value of Check and Try functions, recovered by Handle function.
+type checkPanic struct {
+	err error
+}
+
+// Error method returns error message (for the panic message if not recovered).
+func (p *checkPanic) Error() string {
+	return p.err.Error()
+}
+
+// Unwrap method returns error instance in checkPanic.
+func (p *checkPanic) Unwrap() error {
+	return p.err
+}
+
+// Must function returns v if err is nil, or panics with *Error instance
+// wrapping err with the caller ("function" context) and stack trace ("stack" context, see WithStack function).
+// This function is used in initialization code (the panic is not recovered by Handle function).
+func Must[T any](v T, err error) T {
+	if err != nil {
+		panic(newError(err, true, 2, WithStack()))
+	}
+	return v
+}
+
+// Check function panics if err is not nil, and the panic is recovered by deferred Handle function.
+// The error is wrapped with the caller ("function" context), stack trace ("stack" context) and options.
+func Check(err error, opts ...ErrorContextFunc) {
+	if err != nil {
+		panic(&checkPanic{err: newError(err, true, 2, append([]ErrorContextFunc{WithStack()}, opts...)...)})
+	}
+}
+
+// Try function returns v if err is nil, or panics in the same manner as Check function.
+func Try[T any](v T, err error) T {
+	if err != nil {
+		panic(&checkPanic{err: newError(err, true, 2, WithStack())})
+	}
+	return v
+}
+
+// Handle function recovers the panic by Check and Try functions, and sets the error to *errp with context by options.
+// If *errp has been already set, the errors are joined.
+// This function must be called directly by defer statement:
+//
+//	func run() (err error) {
+//		defer errs.Handle(&err)
+//		errs.Check(step1())
+//		v := errs.Try(step2())
+//		...
+//	}
+//
+// Other panics (including Must function) are not recovered (re-panicked).
+func Handle(errp *error, opts ...ErrorContextFunc) {
+	v := recover()
+	if v == nil {
+		return
+	}
+	cp, ok := v.(*checkPanic)
+	if !ok || errp == nil {
+		panic(v)
+	}
+	if e, ok := cp.err.(*Error); ok {
+		for _, opt := range opts {
+			opt(e)
+		}
+	}
+	if *errp != nil {
+		*errp = Join(*errp, cp.err)
+		return
+	}
+	*errp = cp.err
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,172 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMust(t *testing.T) {
+	if got := Must(strconv.Atoi("42")); got != 42 {
+		t.Errorf("Must() is %v, want %v", got, 42)
+	}
+	defer func() {
+		v := recover()
+		e, ok := v.(*Error)
+		if !ok {
+			t.Fatalf("recover() is %T, want *Error", v)
+		}
+		if got, want := e.Context["function"], "github.com/goark/errs.TestMust"; got != want {
+			t.Errorf("Context[function] is %v, want %v", got, want)
+		}
+		stack, ok := e.Context["stack"].([]string)
+		if !ok || len(stack) == 0 || !strings.HasPrefix(stack[0], "github.com/goark/errs.TestMust ") {
+			t.Errorf("Context[stack] is %v, want stack from TestMust", e.Context["stack"])
+		}
+		if !errors.Is(e, strconv.ErrSyntax) {
+			t.Errorf("errors.Is(%v, strconv.ErrSyntax) is false, want true", e)
+		}
+	}()
+	_ = Must(strconv.Atoi("foo"))
+	t.Error("Must() does not panic")
+}
+
+func checkFunc(fail bool) (n int, err error) {
+	defer Handle(&err, WithContext("foo", "bar"))
+	Check(nil)
+	n = Try(strconv.Atoi("42"))
+	if fail {
+		Check(os.ErrNotExist, WithContext("path", "not-exist.txt"))
+	}
+	return n, nil
+}
+
+func TestCheckHandle(t *testing.T) {
+	n, err := checkFunc(false)
+	if n != 42 || err != nil {
+		t.Errorf("checkFunc(false) is (%v, %v), want (42, <nil>)", n, err)
+	}
+	_, err = checkFunc(true)
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("checkFunc(true) is %T, want *Error", err)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("errors.Is(%v, os.ErrNotExist) is false, want true", err)
+	}
+	for k, want := range map[string]interface{}{"function": "github.com/goark/errs.checkFunc", "foo": "bar", "path": "not-exist.txt"} {
+		if got := e.Context[k]; got != want {
+			t.Errorf("Context[%v] is %v, want %v", k, got, want)
+		}
+	}
+	stack, ok := e.Context["stack"].([]string)
+	if !ok || len(stack) == 0 || !strings.HasPrefix(stack[0], "github.com/goark/errs.checkFunc ") {
+		t.Errorf("Context[stack] is %v, want stack from checkFunc", e.Context["stack"])
+	}
+}
+
+func TestHandleJoin(t *testing.T) {
+	f := func() (err error) {
+		defer Handle(&err)
+		err = io.EOF
+		Check(io.ErrUnexpectedEOF)
+		return
+	}
+	err := f()
+	if !errors.Is(err, io.EOF) || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("f() is %v, want joined error", err)
+	}
+}
+
+func TestHandleRepanic(t *testing.T) {
+	testCases := []struct {
+		fn func()
+	}{
+		{fn: func() { panic("other panic") }},
+		{fn: func() { _ = Must(0, io.EOF) }},
+	}
+
+	for i, tc := range testCases {
+		func() {
+			defer func() {
+				if v := recover(); v == nil {
+					t.Errorf("case %d: panic is recovered by Handle, want re-panic", i)
+				}
+			}()
+			func() (err error) {
+				defer Handle(&err)
+				tc.fn()
+				return nil
+			}()
+		}()
+	}
+}
+
+func TestWithStack(t *testing.T) {
+	testCases := []struct {
+		err error
+	}{
+		{err: New("message", WithStack())},
+		{err: Wrap(io.EOF, WithStack())},
+		{err: ErrorfWith(false, WithStack())("message %w", io.EOF)},
+		{err: New("message").(*Error).SetContext("dummy", nil)},
+	}
+
+	for i, tc := range testCases {
+		e := tc.err.(*Error)
+		if i == len(testCases)-1 {
+			WithStack()(e)
+		}
+		stack, ok := e.Context["stack"].([]string)
+		if !ok || len(stack) == 0 || !strings.HasPrefix(stack[0], "github.com/goark/errs.TestWithStack ") {
+			t.Errorf("case %d: Context[stack] is %v, want stack from TestWithStack", i, e.Context["stack"])
+		}
+	}
+}
+
+func TestWithCallerSkip(t *testing.T) {
+	testCases := []struct {
+		opts []ErrorContextFunc
+	}{
+		{opts: []ErrorContextFunc{WithCallerSkip(1), WithStack()}},
+		{opts: []ErrorContextFunc{WithStack(), WithCallerSkip(1)}},
+		{opts: []ErrorContextFunc{WithContext("foo", 1), WithStack(), WithCallerSkip(1)}},
+	}
+	want := "github.com/goark/errs.TestWithCallerSkip"
+	for i, tc := range testCases {
+		var fnames []interface{}
+		unregister := RegisterHook(func(e *Error) { fnames = append(fnames, e.Context["function"]) })
+		helper := func() error {
+			return New("message", tc.opts...)
+		}
+		e := helper().(*Error)
+		unregister()
+		if got := e.Context["function"]; got != want {
+			t.Errorf("case %d: Context[function] is %v, want %v", i, got, want)
+		}
+		if stack, ok := e.Context["stack"].([]string); !ok || len(stack) == 0 || !strings.HasPrefix(stack[0], want+" ") {
+			t.Errorf("case %d: Context[stack] is %v, want stack from TestWithCallerSkip", i, e.Context["stack"])
+		}
+		if len(fnames) != 1 || fnames[0] != want {
+			t.Errorf("case %d: function context in hook is %v, want %v", i, fnames, want)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,22 @@
+module github.com/goark/errs/otelerrs
+
+go 1.20
+
+require (
+	github.com/goark/errs v1.4.0
+	go.opentelemetry.io/otel v1.19.0
+	go.opentelemetry.io/otel/sdk v1.19.0
+	go.opentelemetry.io/otel/trace v1.19.0
+)
+
+require (
+	github.com/go-logr/logr v1.2.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	go.opentelemetry.io/otel/metric v1.19.0 // indirect
+	golang.org/x/sys v0.12.0 // indirect
+	golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da // indirect
+)
+
+// The errs module is developed in the same repository (see ../go.work).
+// Remove this directive after github.com/goark/errs v1.4.0 is released.
+replace github.com/goark/errs => ../
@@ -0,0 +1,175 @@
+// Package otelerrs records errs error trees on OpenTelemetry spans.
+package otelerrs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goark/errs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	eventName = "exception"
+
+	// Attribute keys of exception event (semantic conventions)
+	keyExceptionType       = attribute.Key("exception.type")
+	keyExceptionMessage    = attribute.Key("exception.message")
+	keyExceptionStacktrace = attribute.Key("exception.stacktrace")
+
+	// Attribute keys of exception event (errs specific)
+	keyCode        = attribute.Key("errs.code")
+	keyTypes       = attribute.Key("errs.types")
+	keyTree        = attribute.Key("errs.tree")
+	contextKeyBase = "errs.context."
+)
+
+// RecordError function records an exception event on the span with attributes derived from the error tree,
+// and sets the span status to codes.Error.
+func RecordError(span trace.Span, err error, opts ...trace.EventOption) {
+	if span == nil || err == nil || !span.IsRecording() {
+		return
+	}
+	opts = append([]trace.EventOption{trace.WithAttributes(Attributes(err)...)}, opts...)
+	span.AddEvent(eventName, opts...)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// Attributes function returns attributes of exception event derived from the error tree.
+// Context data of *errs.Error are flattened into "errs.context.<key>" attributes (outermost value is used).
+func Attributes(err error) []attribute.KeyValue {
+	if err == nil {
+		return nil
+	}
+	attrs := []attribute.KeyValue{
+		keyExceptionType.String(fmt.Sprintf("%T", err)),
+		keyExceptionMessage.String(err.Error()),
+	}
+	var (
+		types []string
+		code  interface{}
+		stack interface{}
+	)
+	ctxValues := map[string]interface{}{}
+	errs.Walk(err, func(e error, _ int) bool {
+		types = append(types, errs.TypeName(e))
+		ee, ok := e.(*errs.Error)
+		if !ok {
+			return true
+		}
+		for k, v := range ee.Context {
+			switch k {
+			case "code":
+				if code == nil {
+					code = v
+				}
+			case "stack":
+				if stack == nil {
+					stack = v
+				}
+			default:
+				if _, ok := ctxValues[k]; !ok {
+					ctxValues[k] = v
+				}
+			}
+		}
+		return true
+	})
+	if code != nil {
+		attrs = append(attrs, keyCode.String(fmt.Sprint(code)))
+	}
+	if s := stackString(stack); len(s) > 0 {
+		attrs = append(attrs, keyExceptionStacktrace.String(s))
+	}
+	keys := make([]string, 0, len(ctxValues))
+	for k := range ctxValues {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attrs = append(attrs, attributeOf(contextKeyBase+k, ctxValues[k]))
+	}
+	attrs = append(attrs, keyTypes.StringSlice(types), keyTree.String(errs.EncodeJSON(err)))
+	return attrs
+}
+
+// WithSpanContext function returns errs.ErrorContextFunc function value.
+// This function stamps trace_id and span_id of the span in context.Context onto *errs.Error.
+func WithSpanContext(ctx context.Context) errs.ErrorContextFunc {
+	return func(e *errs.Error) {
+		if ctx == nil {
+			return
+		}
+		sc := trace.SpanContextFromContext(ctx)
+		if sc.HasTraceID() {
+			_ = e.SetContext("trace_id", sc.TraceID().String())
+		}
+		if sc.HasSpanID() {
+			_ = e.SetContext("span_id", sc.SpanID().String())
+		}
+	}
+}
+
+// attributeOf returns attribute.KeyValue from any value.
+func attributeOf(key string, value interface{}) attribute.KeyValue {
+	k := attribute.Key(key)
+	switch v := value.(type) {
+	case string:
+		return k.String(v)
+	case bool:
+		return k.Bool(v)
+	case int:
+		return k.Int(v)
+	case int64:
+		return k.Int64(v)
+	case float64:
+		return k.Float64(v)
+	case []string:
+		return k.StringSlice(v)
+	case fmt.Stringer:
+		return k.String(v.String())
+	default:
+		return k.String(fmt.Sprint(v))
+	}
+}
+
+// stackString returns stack trace string from "stack" context value.
+func stackString(stack interface{}) string {
+	switch v := stack.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case []string:
+		return strings.Join(v, "\n")
+	case []interface{}:
+		lines := make([]string, 0, len(v))
+		for _, l := range v {
+			lines = append(lines, fmt.Sprint(l))
+		}
+		return strings.Join(lines, "\n")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
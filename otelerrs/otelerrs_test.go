@@ -0,0 +1,122 @@
+package otelerrs_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/otelerrs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTracer() (*tracetest.InMemoryExporter, *sdktrace.TracerProvider) {
+	exp := tracetest.NewInMemoryExporter()
+	return exp, sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+}
+
+func TestRecordError(t *testing.T) {
+	exp, tp := newTracer()
+	ctx, span := tp.Tracer("test").Start(context.Background(), "op")
+	err := errs.New(
+		"outer error",
+		errs.WithCause(errs.Wrap(os.ErrNotExist, errs.WithContext("path", "not-exist.txt"), errs.WithContext("code", "E404"))),
+		errs.WithContext("count", 3),
+		otelerrs.WithSpanContext(ctx),
+	)
+	otelerrs.RecordError(span, err)
+	span.End()
+
+	spans := exp.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("count of spans is %v, want %v", len(spans), 1)
+	}
+	s := spans[0]
+	if s.Status.Code != codes.Error {
+		t.Errorf("status code is %v, want %v", s.Status.Code, codes.Error)
+	}
+	if s.Status.Description != err.Error() {
+		t.Errorf("status description is %q, want %q", s.Status.Description, err.Error())
+	}
+	if len(s.Events) != 1 || s.Events[0].Name != "exception" {
+		t.Fatalf("events is %v, want one exception event", s.Events)
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range s.Events[0].Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	testCases := []struct {
+		key  attribute.Key
+		want string
+	}{
+		{key: "exception.type", want: "*errs.Error"},
+		{key: "exception.message", want: "outer error: file does not exist"},
+		{key: "errs.code", want: "E404"},
+		{key: "errs.context.count", want: "3"},
+		{key: "errs.context.path", want: "not-exist.txt"},
+		{key: "errs.context.function", want: "github.com/goark/errs/otelerrs_test.TestRecordError"},
+		{key: "errs.context.trace_id", want: span.SpanContext().TraceID().String()},
+		{key: "errs.context.span_id", want: span.SpanContext().SpanID().String()},
+		{key: "errs.types", want: "[*errs.Error *errors.errorString *errs.Error *errors.errorString]"},
+		{key: "errs.tree", want: errs.EncodeJSON(err)},
+	}
+	for _, tc := range testCases {
+		v, ok := attrs[tc.key]
+		if !ok {
+			t.Errorf("attribute %q is not found", tc.key)
+			continue
+		}
+		if got := v.Emit(); got != tc.want {
+			t.Errorf("attribute %q is %v, want %v", tc.key, got, tc.want)
+		}
+	}
+}
+
+func TestRecordErrorNil(t *testing.T) {
+	exp, tp := newTracer()
+	_, span := tp.Tracer("test").Start(context.Background(), "op")
+	otelerrs.RecordError(span, nil)
+	span.End()
+
+	spans := exp.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("count of spans is %v, want %v", len(spans), 1)
+	}
+	if len(spans[0].Events) != 0 {
+		t.Errorf("events is %v, want no events", spans[0].Events)
+	}
+	if spans[0].Status.Code != codes.Unset {
+		t.Errorf("status code is %v, want %v", spans[0].Status.Code, codes.Unset)
+	}
+}
+
+func TestWithSpanContext(t *testing.T) {
+	err := errs.New("error", otelerrs.WithSpanContext(context.Background()))
+	e, ok := err.(*errs.Error)
+	if !ok {
+		t.Fatalf("type of error is %T, want *errs.Error", err)
+	}
+	for _, k := range []string{"trace_id", "span_id"} {
+		if _, ok := e.Context[k]; ok {
+			t.Errorf("context %q is set without span", k)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,133 @@
+// Package pkgerrors implements drop-in replacement functions of github.com/pkg/errors package by errs package.
+// Migration is a mechanical rewrite of import path:
+//
+//	import "github.com/pkg/errors" -> import errors "github.com/goark/errs/pkgerrors"
+//
+// Errors are *errs.Error instances with "function" context value (the caller of the function in this package),
+// and "stack" context value if the function of pkg/errors package records stack trace.
+package pkgerrors
+
+import (
+	"fmt"
+
+	"github.com/goark/errs"
+)
+
+// fundamental is error with message only (the Err of *errs.Error instance created by New and Wrap functions).
+// Unlike errors.New function, it is created even if the message is empty (same as github.com/pkg/errors package).
+type fundamental struct {
+	msg string
+}
+
+func (f *fundamental) Error() string { return f.msg }
+
+// New function returns an error with the message and stack trace.
+func New(message string) error {
+	return errs.Wrap(&fundamental{msg: message}, options(true)...)
+}
+
+// Errorf function returns an error formatted according to a format specifier, with stack trace (see errs.Errorf function).
+func Errorf(format string, args ...interface{}) error {
+	return errs.ErrorfWith(false, options(true)...)(format, args...)
+}
+
+// WithStack function returns an error with stack trace wrapping err.
+// If err is nil, WithStack function returns nil.
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errs.Wrap(err, options(true)...)
+}
+
+// Wrap function returns an error with the message and stack trace, and err as the cause.
+// The error message is "message: err.Error()". If err is nil, Wrap function returns nil.
+func Wrap(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	return errs.Wrap(&fundamental{msg: message}, append(options(true), errs.WithCause(err))...)
+}
+
+// Wrapf function returns an error with the formatted message and stack trace, and err as the cause.
+// If err is nil, Wrapf function returns nil.
+func Wrapf(err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return errs.Wrap(&fundamental{msg: fmt.Sprintf(format, args...)}, append(options(true), errs.WithCause(err))...)
+}
+
+// WithMessage function returns an error with the message (without stack trace), and err as the cause.
+// If err is nil, WithMessage function returns nil.
+func WithMessage(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	return errs.Wrap(&fundamental{msg: message}, append(options(false), errs.WithCause(err))...)
+}
+
+// WithMessagef function returns an error with the formatted message (without stack trace), and err as the cause.
+// If err is nil, WithMessagef function returns nil.
+func WithMessagef(err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return errs.Wrap(&fundamental{msg: fmt.Sprintf(format, args...)}, append(options(false), errs.WithCause(err))...)
+}
+
+// Cause function returns the underlying cause of err.
+// It follows Cause() error method (github.com/pkg/errors package) and cause of *errs.Error instance.
+func Cause(err error) error {
+	for err != nil {
+		var next error
+		switch e := err.(type) {
+		case *errs.Error:
+			if _, ok := e.Err.(*fundamental); !ok || e.Cause != nil {
+				next = e.Unwrap()
+			}
+		case interface{ Cause() error }:
+			next = e.Cause()
+		}
+		if next == nil || next == err {
+			break
+		}
+		err = next
+	}
+	return err
+}
+
+// Is is compatible with errors.Is.
+func Is(err, target error) bool { return errs.Is(err, target) }
+
+// As is compatible with errors.As.
+func As(err error, target interface{}) bool { return errs.As(err, target) }
+
+// Unwrap is compatible with errors.Unwrap.
+func Unwrap(err error) error { return errs.Unwrap(err) }
+
+// options returns options of errs package that set "function" context value
+// (and "stack" context value if stack is true) to the caller of exported function in this package.
+// They are applied before hook functions are called (see errs.RegisterHook function).
+func options(stack bool) []errs.ErrorContextFunc {
+	opts := []errs.ErrorContextFunc{errs.WithCallerSkip(1)}
+	if stack {
+		opts = append(opts, errs.WithStack())
+	}
+	return opts
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,115 @@
+package pkgerrors
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/goark/errs"
+)
+
+func TestShim(t *testing.T) {
+	testCases := []struct {
+		err   error
+		msg   string
+		stack bool
+		cause error
+	}{
+		{err: New("foo"), msg: "foo", stack: true},
+		{err: Errorf("foo %d", 1), msg: "foo 1", stack: true},
+		{err: Errorf("foo: %w", io.EOF), msg: "foo: EOF", stack: true, cause: io.EOF},
+		{err: WithStack(io.EOF), msg: "EOF", stack: true, cause: io.EOF},
+		{err: Wrap(io.EOF, "foo"), msg: "foo: EOF", stack: true, cause: io.EOF},
+		{err: Wrapf(io.EOF, "foo %d", 1), msg: "foo 1: EOF", stack: true, cause: io.EOF},
+		{err: WithMessage(io.EOF, "foo"), msg: "foo: EOF", stack: false, cause: io.EOF},
+		{err: WithMessagef(io.EOF, "foo %d", 1), msg: "foo 1: EOF", stack: false, cause: io.EOF},
+		{err: Wrap(WithMessage(io.EOF, "foo"), "bar"), msg: "bar: foo: EOF", stack: true, cause: io.EOF},
+		{err: New(""), msg: "", stack: true},
+		{err: Errorf(""), msg: "", stack: true},
+		{err: Wrap(io.EOF, ""), msg: "EOF", stack: true, cause: io.EOF},
+		{err: Wrapf(io.EOF, ""), msg: "EOF", stack: true, cause: io.EOF},
+		{err: WithMessage(io.EOF, ""), msg: "EOF", stack: false, cause: io.EOF},
+		{err: WithMessagef(io.EOF, ""), msg: "EOF", stack: false, cause: io.EOF},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.err.Error(); got != tc.msg {
+			t.Errorf("Error() is %q, want %q", got, tc.msg)
+		}
+		e, ok := tc.err.(*errs.Error)
+		if !ok {
+			t.Errorf("%v is %T, want *errs.Error", tc.err, tc.err)
+			continue
+		}
+		if got := e.Context["function"]; got != "github.com/goark/errs/pkgerrors.TestShim" {
+			t.Errorf("function context of %v is %v, want %v", tc.err, got, "github.com/goark/errs/pkgerrors.TestShim")
+		}
+		stack, ok := e.Context["stack"].([]string)
+		if ok != tc.stack {
+			t.Errorf("stack context of %v exists is %v, want %v", tc.err, ok, tc.stack)
+		} else if ok && !strings.HasPrefix(stack[0], "github.com/goark/errs/pkgerrors.TestShim ") {
+			t.Errorf("stack context of %v is %v, want this function first", tc.err, stack)
+		}
+		if tc.cause != nil {
+			if got := Cause(tc.err); got != tc.cause {
+				t.Errorf("Cause(%v) is %v, want %v", tc.err, got, tc.cause)
+			}
+			if !Is(tc.err, tc.cause) {
+				t.Errorf("Is(%v, %v) is false, want true", tc.err, tc.cause)
+			}
+		} else if got := Cause(tc.err); got != tc.err {
+			t.Errorf("Cause(%v) is %v, want itself", tc.err, got)
+		}
+	}
+}
+
+func TestShimNil(t *testing.T) {
+	for _, err := range []error{WithStack(nil), Wrap(nil, "foo"), Wrapf(nil, "foo"), WithMessage(nil, "foo"), WithMessagef(nil, "foo"), Cause(nil)} {
+		if err != nil {
+			t.Errorf("shim function with nil is %v, want <nil>", err)
+		}
+	}
+}
+
+func TestShimHook(t *testing.T) {
+	var fnames []interface{}
+	defer errs.RegisterHook(func(e *errs.Error) { fnames = append(fnames, e.Context["function"]) })()
+	_ = New("foo")
+	_ = Errorf("foo: %w", io.EOF)
+	_ = Wrap(io.EOF, "foo")
+	_ = WithMessage(io.EOF, "foo")
+	if len(fnames) != 4 {
+		t.Fatalf("hook is called %v times, want %v", len(fnames), 4)
+	}
+	for _, fname := range fnames {
+		if fname != "github.com/goark/errs/pkgerrors.TestShimHook" {
+			t.Errorf("function context in hook is %v, want %v", fname, "github.com/goark/errs/pkgerrors.TestShimHook")
+		}
+	}
+}
+
+func TestAs(t *testing.T) {
+	var target *errs.Error
+	if err := Wrap(io.EOF, "foo"); !As(err, &target) {
+		t.Errorf("As(%v) is false, want true", err)
+	}
+	if got := Unwrap(WithStack(io.EOF)); !errors.Is(got, io.EOF) {
+		t.Errorf("Unwrap() is %v, want %v", got, io.EOF)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
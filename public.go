@@ -0,0 +1,82 @@
+package errs
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// DefaultPublicMessage is the generic message returned by PublicMessage function
+// when error tree has no public message.
+var DefaultPublicMessage = "internal error"
+
+// OutputMode type is output mode of encoders (EncodeJSONMode function etc.).
+type OutputMode int
+
+const (
+	// OutputInternal outputs all informations of error tree: messages, context data and causes (default).
+	OutputInternal OutputMode = iota
+	// OutputPublic outputs public message only (see PublicMessage function).
+	// Internal messages, context data and causes are never output.
+	OutputPublic
+)
+
+// WithPublicMessage function returns ErrorContextFunc function value.
+// This function is used in New and Wrap functions that represents the message safe to show API users.
+// The public message is not stored in context data, so it is never overwritten by WithContext function.
+func WithPublicMessage(msg string) ErrorContextFunc {
+	return func(e *Error) {
+		if e != nil {
+			e.public = msg
+		}
+	}
+}
+
+// PublicMessage function returns the outermost public message (see WithPublicMessage function) in error tree.
+// If error tree has no public message, it returns DefaultPublicMessage.
+// It returns empty string if err is nil.
+func PublicMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	msg := ""
+	if FindOutermost(err, func(e error) bool {
+		if ee, ok := e.(*Error); ok && ee != nil {
+			msg = ee.public
+		}
+		return len(msg) > 0
+	}) == nil {
+		return DefaultPublicMessage
+	}
+	return msg
+}
+
+// EncodeJSONMode function dumps out error instance with JSON format in the output mode.
+// In OutputPublic mode, it outputs {"Msg":<public message>} only.
+func EncodeJSONMode(err error, mode OutputMode) string {
+	if mode != OutputPublic {
+		return EncodeJSON(err)
+	}
+	if err == nil {
+		return "null"
+	}
+	msgBuf := &bytes.Buffer{}
+	json.HTMLEscape(msgBuf, bytes.Join([][]byte{[]byte(`"Msg":`), []byte(strconv.Quote(PublicMessage(err)))}, []byte{}))
+	return strings.Join([]string{"{", msgBuf.String(), "}"}, "")
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
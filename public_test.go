@@ -0,0 +1,78 @@
+package errs
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestPublicMessage(t *testing.T) {
+	testCases := []struct {
+		err  error
+		msg  string
+		json string
+	}{
+		{err: nil, msg: "", json: "null"},
+		{err: os.ErrInvalid, msg: DefaultPublicMessage, json: `{"Msg":"internal error"}`},
+		{err: New("internal detail"), msg: DefaultPublicMessage, json: `{"Msg":"internal error"}`},
+		{err: New("internal detail", WithPublicMessage("bad request")), msg: "bad request", json: `{"Msg":"bad request"}`},
+		{
+			err:  Wrap(New("internal detail", WithPublicMessage("inner <message>")), WithPublicMessage("outer message")),
+			msg:  "outer message",
+			json: `{"Msg":"outer message"}`,
+		},
+		{
+			err:  New("internal detail", WithCause(New("inner detail", WithPublicMessage("inner <message>")))),
+			msg:  "inner <message>",
+			json: `{"Msg":"inner \u003cmessage\u003e"}`,
+		},
+		{
+			err:  errors.Join(os.ErrInvalid, Wrap(os.ErrNotExist, WithPublicMessage("not found"))),
+			msg:  "not found",
+			json: `{"Msg":"not found"}`,
+		},
+		{err: New("internal detail", WithContext("public", "internal <detail>")), msg: DefaultPublicMessage, json: `{"Msg":"internal error"}`},
+		{err: New("internal detail", WithPublicMessage("bad request"), WithContext("public", "internal detail")), msg: "bad request", json: `{"Msg":"bad request"}`},
+	}
+
+	for _, tc := range testCases {
+		if got := PublicMessage(tc.err); got != tc.msg {
+			t.Errorf("PublicMessage(%v) is %v, want %v", tc.err, got, tc.msg)
+		}
+		if got := EncodeJSONMode(tc.err, OutputPublic); got != tc.json {
+			t.Errorf("EncodeJSONMode(%v, OutputPublic) is %v, want %v", tc.err, got, tc.json)
+		}
+		if got := EncodeJSONMode(tc.err, OutputInternal); got != EncodeJSON(tc.err) {
+			t.Errorf("EncodeJSONMode(%v, OutputInternal) is %v, want %v", tc.err, got, EncodeJSON(tc.err))
+		}
+	}
+}
+
+func TestPublicMessageJSON(t *testing.T) {
+	err := New("error", WithPublicMessage("bad <request>"))
+	want := `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"error"},"Context":{"function":"github.com/goark/errs.TestPublicMessageJSON"},"Public":"bad \u003crequest\u003e"}`
+	if got := EncodeJSON(err); got != want {
+		t.Errorf("EncodeJSON(%v) is %v, want %v", err, got, want)
+	}
+	derr, e := DecodeJSON([]byte(want))
+	if e != nil {
+		t.Errorf("DecodeJSON() is \"%v\", want <nil>", e)
+	} else if got := PublicMessage(derr); got != "bad <request>" {
+		t.Errorf("PublicMessage(DecodeJSON()) is %v, want %v", got, "bad <request>")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
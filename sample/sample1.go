@@ -10,7 +10,7 @@ import (
 	"github.com/goark/errs"
 )
 
-func checkFileOpen(path string) error {
+func checkFileOpen(path string) (err error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return errs.New(
@@ -19,7 +19,7 @@ func checkFileOpen(path string) error {
 			errs.WithContext("path", path),
 		)
 	}
-	defer file.Close()
+	defer errs.Close(&err, file)
 
 	return nil
 }
@@ -28,6 +28,6 @@ func main() {
 	if err := checkFileOpen("not-exist.txt"); err != nil {
 		fmt.Printf("%v\n", err)  // file open error: open not-exist.txt: no such file or directory
 		fmt.Printf("%#v\n", err) // *errs.Error{Err:&errors.errorString{s:"file open error"}, Cause:&fs.PathError{Op:"open", Path:"not-exist.txt", Err:0x2}, Context:map[string]interface {}{"function":"main.checkFileOpen", "path":"not-exist.txt"}}
-		fmt.Printf("%+v\n", err) // {"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"file open error"},"Context":{"function":"main.checkFileOpen","path":"not-exist.txt"},"Cause":{"Type":"*fs.PathError","Msg":"open not-exist.txt: no such file or directory","Cause":{"Type":"syscall.Errno","Msg":"no such file or directory"}}}
+		fmt.Printf("%+v\n", err) // {"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"file open error"},"Context":{"function":"main.checkFileOpen","path":"not-exist.txt"},"Cause":{"Type":"*fs.PathError","Msg":"open not-exist.txt: no such file or directory","Fields":{"Op":"open","Path":"not-exist.txt"},"Cause":{"Type":"syscall.Errno","Msg":"no such file or directory","Fields":{"Errno":2,"Name":"ENOENT"}}}}
 	}
 }
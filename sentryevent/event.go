@@ -0,0 +1,378 @@
+// Package sentryevent builds Sentry event payloads from errs error trees.
+package sentryevent
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// Event is a Sentry event payload.
+type Event struct {
+	EventID     string                 `json:"event_id"`
+	Timestamp   string                 `json:"timestamp"`
+	Platform    string                 `json:"platform"`
+	Level       string                 `json:"level,omitempty"`
+	Logger      string                 `json:"logger,omitempty"`
+	ServerName  string                 `json:"server_name,omitempty"`
+	Release     string                 `json:"release,omitempty"`
+	Environment string                 `json:"environment,omitempty"`
+	Exception   *ExceptionList         `json:"exception,omitempty"`
+	Tags        map[string]string      `json:"tags,omitempty"`
+	Extra       map[string]interface{} `json:"extra,omitempty"`
+	Fingerprint []string               `json:"fingerprint,omitempty"`
+}
+
+// ExceptionList is the exception interface of Sentry event.
+// Values are ordered from cause to outer error.
+type ExceptionList struct {
+	Values []Exception `json:"values"`
+}
+
+// Exception is a value of exception interface.
+type Exception struct {
+	Type       string      `json:"type"`
+	Value      string      `json:"value"`
+	Module     string      `json:"module,omitempty"`
+	Stacktrace *Stacktrace `json:"stacktrace,omitempty"`
+	Mechanism  *Mechanism  `json:"mechanism,omitempty"`
+}
+
+// Mechanism describes relationship of exceptions in error tree.
+type Mechanism struct {
+	Type             string `json:"type"`
+	Source           string `json:"source,omitempty"`
+	ExceptionID      int    `json:"exception_id"`
+	ParentID         *int   `json:"parent_id,omitempty"`
+	IsExceptionGroup bool   `json:"is_exception_group,omitempty"`
+}
+
+// Stacktrace is the stack trace interface of Sentry event.
+// Frames are ordered from oldest to newest call.
+type Stacktrace struct {
+	Frames []Frame `json:"frames"`
+}
+
+// Frame is a frame of stack trace.
+type Frame struct {
+	Function string `json:"function,omitempty"`
+	Module   string `json:"module,omitempty"`
+	AbsPath  string `json:"abs_path,omitempty"`
+	Lineno   int    `json:"lineno,omitempty"`
+}
+
+// Option type is self-referential function type for NewEvent function. (functional options pattern)
+type Option func(*builder)
+
+// WithLevel function returns Option function value.
+// This function sets level of event (default: "error").
+func WithLevel(level string) Option {
+	return func(b *builder) {
+		b.ev.Level = level
+	}
+}
+
+// WithLogger function returns Option function value.
+// This function sets logger name of event.
+func WithLogger(name string) Option {
+	return func(b *builder) {
+		b.ev.Logger = name
+	}
+}
+
+// WithRelease function returns Option function value.
+// This function sets release of event.
+func WithRelease(release string) Option {
+	return func(b *builder) {
+		b.ev.Release = release
+	}
+}
+
+// WithEnvironment function returns Option function value.
+// This function sets environment of event.
+func WithEnvironment(env string) Option {
+	return func(b *builder) {
+		b.ev.Environment = env
+	}
+}
+
+// WithServerName function returns Option function value.
+// This function sets server name of event.
+func WithServerName(name string) Option {
+	return func(b *builder) {
+		b.ev.ServerName = name
+	}
+}
+
+// WithTimestamp function returns Option function value.
+// This function sets timestamp of event (default: current time).
+func WithTimestamp(t time.Time) Option {
+	return func(b *builder) {
+		b.ev.Timestamp = t.UTC().Format(time.RFC3339Nano)
+	}
+}
+
+// WithTagKeys function returns Option function value.
+// Context values of these keys are sent as tags (default: "function" and "code").
+func WithTagKeys(keys ...string) Option {
+	return func(b *builder) {
+		b.tagKeys = keys
+	}
+}
+
+// WithFingerprint function returns Option function value.
+// This function overwrites fingerprint hints of event.
+func WithFingerprint(fingerprint ...string) Option {
+	return func(b *builder) {
+		b.fingerprint = fingerprint
+	}
+}
+
+// builder is working area for NewEvent function.
+type builder struct {
+	ev          *Event
+	tagKeys     []string
+	fingerprint []string
+	excepts     []Exception
+	context     map[string]interface{}
+	hints       []string
+}
+
+// NewEvent function returns Sentry event payload built from error tree.
+// It returns nil if err is nil.
+func NewEvent(err error, opts ...Option) *Event {
+	if err == nil {
+		return nil
+	}
+	b := &builder{
+		ev: &Event{
+			EventID:   newEventID(),
+			Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+			Platform:  "go",
+			Level:     "error",
+		},
+		tagKeys: []string{"function", "code"},
+		context: map[string]interface{}{},
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	b.add(err, nil)
+
+	// Sentry expects the exception values ordered from cause to outer error.
+	values := make([]Exception, 0, len(b.excepts))
+	for i := len(b.excepts) - 1; i >= 0; i-- {
+		values = append(values, b.excepts[i])
+	}
+	b.ev.Exception = &ExceptionList{Values: values}
+
+	for _, k := range b.tagKeys {
+		if v, ok := b.context[k]; ok {
+			if b.ev.Tags == nil {
+				b.ev.Tags = map[string]string{}
+			}
+			b.ev.Tags[k] = fmt.Sprint(v)
+		}
+	}
+	for k, v := range b.context {
+		if k == "stack" {
+			continue
+		}
+		if b.ev.Extra == nil {
+			b.ev.Extra = map[string]interface{}{}
+		}
+		b.ev.Extra[k] = v
+	}
+	if len(b.fingerprint) > 0 {
+		b.ev.Fingerprint = b.fingerprint
+	} else if len(b.hints) > 0 {
+		b.ev.Fingerprint = b.hints
+	}
+	return b.ev
+}
+
+// add appends exceptions of error tree.
+// *errs.Error instance itself is transparent: its Context decorates the exception of Err field.
+func (b *builder) add(err error, parentID *int) {
+	if err == nil {
+		return
+	}
+	if e, ok := err.(*errs.Error); ok {
+		b.addContext(e)
+		if e.Err == nil {
+			b.add(e.Cause, parentID)
+			return
+		}
+		if _, ok := e.Err.(*errs.Error); ok {
+			b.add(e.Err, parentID)
+			b.add(e.Cause, parentID)
+			return
+		}
+		id := b.addException(e.Err, parentID, e, false)
+		for _, c := range errs.Unwraps(e.Err) {
+			b.add(c, &id)
+		}
+		b.add(e.Cause, &id)
+		return
+	}
+	causes := errs.Unwraps(err)
+	id := b.addException(err, parentID, nil, len(causes) > 1)
+	for _, c := range causes {
+		b.add(c, &id)
+	}
+}
+
+// addException appends an exception and returns the exception ID.
+func (b *builder) addException(err error, parentID *int, ee *errs.Error, group bool) int {
+	id := len(b.excepts)
+	typ := fmt.Sprintf("%T", err)
+	ex := Exception{
+		Type:  typ,
+		Value: err.Error(),
+		Mechanism: &Mechanism{
+			Type:             "generic",
+			ExceptionID:      id,
+			ParentID:         parentID,
+			IsExceptionGroup: group,
+		},
+	}
+	if parentID != nil {
+		ex.Mechanism.Type = "chained"
+		ex.Mechanism.Source = "cause"
+	}
+	if id == 0 {
+		b.hints = append(b.hints, "{{ default }}")
+	}
+	b.hints = append(b.hints, typ)
+	if ee != nil {
+		if fn, ok := ee.Context["function"].(string); ok {
+			ex.Module, _ = splitFunction(fn)
+			b.hints = append(b.hints, fn)
+		}
+		if code, ok := ee.Context["code"]; ok {
+			b.hints = append(b.hints, fmt.Sprint(code))
+		}
+		if frames := parseStack(ee.Context["stack"]); len(frames) > 0 {
+			ex.Stacktrace = &Stacktrace{Frames: frames}
+		}
+	}
+	b.excepts = append(b.excepts, ex)
+	return id
+}
+
+// addContext merges Context of *errs.Error (outer value is used).
+func (b *builder) addContext(e *errs.Error) {
+	for k, v := range e.Context {
+		if _, ok := b.context[k]; !ok {
+			b.context[k] = v
+		}
+	}
+}
+
+// splitFunction splits full function name into package path and function name.
+func splitFunction(name string) (string, string) {
+	slash := strings.LastIndex(name, "/")
+	if dot := strings.Index(name[slash+1:], "."); dot >= 0 {
+		return name[:slash+1+dot], name[slash+2+dot:]
+	}
+	return "", name
+}
+
+// parseStack returns stack frames from "stack" context value.
+// The value is a list of "function file:line" lines (newest call first),
+// or a string formatted by runtime/debug.Stack function.
+func parseStack(stack interface{}) []Frame {
+	var lines []string
+	switch v := stack.(type) {
+	case []string:
+		lines = v
+	case []interface{}:
+		for _, l := range v {
+			if s, ok := l.(string); ok {
+				lines = append(lines, s)
+			}
+		}
+	case string:
+		lines = joinDebugStack(v)
+	default:
+		return nil
+	}
+	frames := make([]Frame, 0, len(lines))
+	for i := len(lines) - 1; i >= 0; i-- {
+		fields := strings.Fields(lines[i])
+		if len(fields) == 0 {
+			continue
+		}
+		f := Frame{}
+		f.Module, f.Function = splitFunction(fields[0])
+		if len(fields) > 1 {
+			loc := fields[1]
+			if i := strings.LastIndex(loc, ":"); i >= 0 {
+				f.AbsPath = loc[:i]
+				f.Lineno, _ = strconv.Atoi(loc[i+1:])
+			} else {
+				f.AbsPath = loc
+			}
+		}
+		frames = append(frames, f)
+	}
+	return frames
+}
+
+// joinDebugStack converts output of runtime/debug.Stack function into "function file:line" lines.
+func joinDebugStack(s string) []string {
+	var lines []string
+	var fn string
+	for _, l := range strings.Split(s, "\n") {
+		switch {
+		case strings.HasPrefix(l, "goroutine "):
+		case strings.HasPrefix(l, "\t"):
+			if len(fn) == 0 {
+				continue
+			}
+			loc := strings.TrimSpace(l)
+			if i := strings.Index(loc, " +0x"); i >= 0 {
+				loc = loc[:i]
+			}
+			lines = append(lines, fn+" "+loc)
+			fn = ""
+		case len(l) > 0:
+			fn = l
+			if i := strings.LastIndex(fn, "("); i > 0 {
+				fn = fn[:i]
+			}
+		}
+	}
+	return lines
+}
+
+// newEventID returns random UUID (hex without dashes) for event ID.
+func newEventID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strings.Repeat("0", 32)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return hex.EncodeToString(b)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,117 @@
+package sentryevent
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+func TestNewEventNil(t *testing.T) {
+	if ev := NewEvent(nil); ev != nil {
+		t.Errorf("NewEvent(nil) is %v, want <nil>", ev)
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	err := errs.New(
+		"open config",
+		errs.WithCause(errs.Wrap(os.ErrNotExist, errs.WithContext("path", "config.json"))),
+		errs.WithContext("code", "E001"),
+		errs.WithContext("stack", []string{"main.load /src/main.go:20", "main.main /src/main.go:10"}),
+	)
+	ts := time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+	ev := NewEvent(err, WithTimestamp(ts), WithEnvironment("test"))
+	if len(ev.EventID) != 32 {
+		t.Errorf("EventID is %q, want 32 hex digits", ev.EventID)
+	}
+	ev.EventID = "0"
+	b, e := json.Marshal(ev)
+	if e != nil {
+		t.Fatalf("json.Marshal() is %v, want <nil>", e)
+	}
+	want := `{"event_id":"0","timestamp":"2026-01-02T03:04:05Z","platform":"go","level":"error","environment":"test","exception":{"values":[` +
+		`{"type":"*errors.errorString","value":"file does not exist","module":"github.com/goark/errs/sentryevent","mechanism":{"type":"chained","source":"cause","exception_id":1,"parent_id":0}},` +
+		`{"type":"*errors.errorString","value":"open config","module":"github.com/goark/errs/sentryevent","stacktrace":{"frames":[{"function":"main","module":"main","abs_path":"/src/main.go","lineno":10},{"function":"load","module":"main","abs_path":"/src/main.go","lineno":20}]},"mechanism":{"type":"generic","exception_id":0}}` +
+		`]},"tags":{"code":"E001","function":"github.com/goark/errs/sentryevent.TestNewEvent"},` +
+		`"extra":{"code":"E001","function":"github.com/goark/errs/sentryevent.TestNewEvent","path":"config.json"},` +
+		`"fingerprint":["{{ default }}","*errors.errorString","github.com/goark/errs/sentryevent.TestNewEvent","E001","*errors.errorString","github.com/goark/errs/sentryevent.TestNewEvent"]}`
+	if str := string(b); str != want {
+		t.Errorf("NewEvent() is\n%v\nwant\n%v", str, want)
+	}
+}
+
+func TestNewEventMultiError(t *testing.T) {
+	ev := NewEvent(errs.Join(io.EOF, errors.New("other")), WithFingerprint("fixed"))
+	values := ev.Exception.Values
+	if len(values) != 3 {
+		t.Fatalf("count of exceptions is %v, want %v", len(values), 3)
+	}
+	outer := values[len(values)-1]
+	if outer.Type != "*errs.Errors" || !outer.Mechanism.IsExceptionGroup {
+		t.Errorf("outer exception is %+v, want exception group of *errs.Errors", outer)
+	}
+	for _, v := range values[:2] {
+		if v.Mechanism.ParentID == nil || *v.Mechanism.ParentID != 0 {
+			t.Errorf("parent of %v is %v, want 0", v.Value, v.Mechanism.ParentID)
+		}
+	}
+	if len(ev.Fingerprint) != 1 || ev.Fingerprint[0] != "fixed" {
+		t.Errorf("Fingerprint is %v, want %v", ev.Fingerprint, []string{"fixed"})
+	}
+}
+
+func TestParseStack(t *testing.T) {
+	testCases := []struct {
+		stack interface{}
+		want  []Frame
+	}{
+		{stack: nil, want: nil},
+		{stack: 1, want: nil},
+		{
+			stack: []interface{}{"github.com/foo/bar.(*T).Run /src/bar.go:12", "main.main /src/main.go:3"},
+			want: []Frame{
+				{Function: "main", Module: "main", AbsPath: "/src/main.go", Lineno: 3},
+				{Function: "(*T).Run", Module: "github.com/foo/bar", AbsPath: "/src/bar.go", Lineno: 12},
+			},
+		},
+		{
+			stack: "goroutine 1 [running]:\nruntime/debug.Stack()\n\t/go/src/runtime/debug/stack.go:24 +0x5e\nmain.main()\n\t/src/main.go:9 +0x17\n",
+			want: []Frame{
+				{Function: "main", Module: "main", AbsPath: "/src/main.go", Lineno: 9},
+				{Function: "Stack", Module: "runtime/debug", AbsPath: "/go/src/runtime/debug/stack.go", Lineno: 24},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		got := parseStack(tc.stack)
+		if len(got) != len(tc.want) {
+			t.Errorf("parseStack(%v) is %v, want %v", tc.stack, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("parseStack(%v)[%d] is %v, want %v", tc.stack, i, got[i], tc.want[i])
+			}
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
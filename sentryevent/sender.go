@@ -0,0 +1,142 @@
+package sentryevent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+const (
+	sentryVersion = "7"
+	sentryClient  = "goark-errs-sentryevent/1.0"
+)
+
+// Sender is an interface for sending Sentry event.
+type Sender interface {
+	Send(ctx context.Context, ev *Event) error
+}
+
+// HTTPSender is a Sender that posts Sentry event to the store endpoint of Sentry server (or relay).
+type HTTPSender struct {
+	client    *http.Client
+	endpoint  string
+	publicKey string
+}
+
+var _ Sender = (*HTTPSender)(nil) //HTTPSender type is compatible with Sender interface
+
+// NewHTTPSender function returns HTTPSender instance from DSN.
+// If client is nil, http.DefaultClient is used.
+func NewHTTPSender(dsn string, client *http.Client) (*HTTPSender, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		if ue, ok := err.(*url.Error); ok {
+			ue.URL = redactDSN(ue.URL)
+		}
+		return nil, errs.Wrap(err, errs.WithContext("dsn", redactDSN(dsn)))
+	}
+	if u.User == nil || len(u.User.Username()) == 0 {
+		return nil, errs.New("public key is not found in DSN", errs.WithContext("dsn", redactDSN(dsn)))
+	}
+	path := strings.Trim(u.Path, "/")
+	i := strings.LastIndex(path, "/")
+	projectID := path[i+1:]
+	if len(projectID) == 0 {
+		return nil, errs.New("project ID is not found in DSN", errs.WithContext("dsn", redactDSN(dsn)))
+	}
+	prefix := path[:i+1]
+	if client == nil {
+		client = http.DefaultClient
+	}
+	endpoint := &url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/" + prefix + "api/" + projectID + "/store/"}
+	return &HTTPSender{client: client, endpoint: endpoint.String(), publicKey: u.User.Username()}, nil
+}
+
+// redactDSN returns DSN without user information (public key and secret key) for error context.
+func redactDSN(dsn string) string {
+	i := strings.Index(dsn, "://")
+	if i < 0 {
+		i = 0
+	} else {
+		i += len("://")
+	}
+	rest := dsn[i:]
+	if j := strings.IndexAny(rest, "/?#"); j >= 0 {
+		rest = rest[:j]
+	}
+	at := strings.LastIndex(rest, "@")
+	if at < 0 {
+		return dsn
+	}
+	return dsn[:i] + dsn[i+at+1:]
+}
+
+// Endpoint method returns URL of the store endpoint.
+func (s *HTTPSender) Endpoint() string {
+	if s == nil {
+		return ""
+	}
+	return s.endpoint
+}
+
+// Send method posts Sentry event.
+func (s *HTTPSender) Send(ctx context.Context, ev *Event) error {
+	if s == nil {
+		return errs.New("nil sender")
+	}
+	if ev == nil {
+		return nil
+	}
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("event_id", ev.EventID))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(b))
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("endpoint", s.endpoint))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", sentryClient)
+	req.Header.Set("X-Sentry-Auth", strings.Join([]string{
+		"Sentry sentry_version=" + sentryVersion,
+		"sentry_client=" + sentryClient,
+		"sentry_key=" + s.publicKey,
+	}, ", "))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("endpoint", s.endpoint), errs.WithContext("event_id", ev.EventID))
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errs.New(
+			"unexpected response from Sentry server",
+			errs.WithContext("endpoint", s.endpoint),
+			errs.WithContext("event_id", ev.EventID),
+			errs.WithContext("status", resp.StatusCode),
+			errs.WithContext("body", string(body)),
+		)
+	}
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,117 @@
+package sentryevent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/sentryevent"
+)
+
+func TestNewHTTPSender(t *testing.T) {
+	testCases := []struct {
+		dsn      string
+		endpoint string
+		ok       bool
+	}{
+		{dsn: "https://key@sentry.example.com/42", endpoint: "https://sentry.example.com/api/42/store/", ok: true},
+		{dsn: "http://key@relay.local:3000/sentry/7", endpoint: "http://relay.local:3000/sentry/api/7/store/", ok: true},
+		{dsn: "https://sentry.example.com/42", ok: false},
+		{dsn: "https://key@sentry.example.com/", ok: false},
+		{dsn: "://", ok: false},
+		{dsn: "https://secretkey@sentry.example.com:bad/42", ok: false},
+	}
+	for _, tc := range testCases {
+		s, err := sentryevent.NewHTTPSender(tc.dsn, nil)
+		if (err == nil) != tc.ok {
+			t.Errorf("NewHTTPSender(%q) error is %v, want ok=%v", tc.dsn, err, tc.ok)
+			continue
+		}
+		if err != nil {
+			if str := fmt.Sprintf("%+v", err); strings.Contains(str, "key@") {
+				t.Errorf("NewHTTPSender(%q) error is %v, want without key", tc.dsn, str)
+			}
+			continue
+		}
+		if got := s.Endpoint(); got != tc.endpoint {
+			t.Errorf("NewHTTPSender(%q).Endpoint() is %v, want %v", tc.dsn, got, tc.endpoint)
+		}
+	}
+}
+
+func TestSend(t *testing.T) {
+	var (
+		auth string
+		got  sentryevent.Event
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/store/" {
+			http.NotFound(w, r)
+			return
+		}
+		auth = r.Header.Get("X-Sentry-Auth")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"` + got.EventID + `"}`))
+	}))
+	defer srv.Close()
+
+	s, err := sentryevent.NewHTTPSender("http://public@"+srv.Listener.Addr().String()+"/1", srv.Client())
+	if err != nil {
+		t.Fatalf("NewHTTPSender() is %v, want <nil>", err)
+	}
+	ev := sentryevent.NewEvent(errs.Wrap(os.ErrInvalid))
+	if err := s.Send(context.Background(), ev); err != nil {
+		t.Fatalf("Send() is %v, want <nil>", err)
+	}
+	if got.EventID != ev.EventID {
+		t.Errorf("received event ID is %v, want %v", got.EventID, ev.EventID)
+	}
+	if want := "Sentry sentry_version=7, sentry_client=goark-errs-sentryevent/1.0, sentry_key=public"; auth != want {
+		t.Errorf("X-Sentry-Auth is %q, want %q", auth, want)
+	}
+}
+
+func TestSendError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "rate limited", http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	s, err := sentryevent.NewHTTPSender("http://public@"+srv.Listener.Addr().String()+"/1", srv.Client())
+	if err != nil {
+		t.Fatalf("NewHTTPSender() is %v, want <nil>", err)
+	}
+	err = s.Send(context.Background(), sentryevent.NewEvent(errors.New("error")))
+	var e *errs.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("Send() is %v, want *errs.Error", err)
+	}
+	if status := e.Context["status"]; status != http.StatusTooManyRequests {
+		t.Errorf("status in context is %v, want %v", status, http.StatusTooManyRequests)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,158 @@
+package errs
+
+import (
+	"encoding"
+	"encoding/gob"
+	"strings"
+)
+
+// Level type is severity level of error.
+type Level int
+
+const (
+	// LevelUnknown means that severity is not specified.
+	LevelUnknown Level = iota
+	// LevelDebug is severity for errors only useful in debugging.
+	LevelDebug
+	// LevelInfo is severity for expected errors (e.g. validation errors).
+	LevelInfo
+	// LevelWarn is severity for errors that should be checked but need no immediate action.
+	LevelWarn
+	// LevelError is severity for ordinary errors.
+	LevelError
+	// LevelCritical is severity for errors that need immediate action.
+	LevelCritical
+)
+
+// DefaultSeverity is the severity returned by Severity function when error tree has no severity.
+const DefaultSeverity = LevelError
+
+var _ encoding.TextMarshaler = LevelUnknown    //Level type is compatible with encoding.TextMarshaler interface
+var _ encoding.TextUnmarshaler = (*Level)(nil) //Level type is compatible with encoding.TextUnmarshaler interface
+
+var levelNames = map[Level]string{
+	LevelUnknown:  "unknown",
+	LevelDebug:    "debug",
+	LevelInfo:     "info",
+	LevelWarn:     "warn",
+	LevelError:    "error",
+	LevelCritical: "critical",
+}
+
+func init() {
+	gob.RegisterName("github.com/goark/errs.Level", LevelUnknown)
+}
+
+// String method returns name of severity level (e.g. "warn").
+// This method is implementation of fmt.Stringer interface.
+func (l Level) String() string {
+	if name, ok := levelNames[l]; ok {
+		return name
+	}
+	return levelNames[LevelUnknown]
+}
+
+// MarshalText method returns name of severity level.
+// This method is implementation of encoding.TextMarshaler interface.
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText method sets severity level from its name.
+// This method is implementation of encoding.TextUnmarshaler interface.
+func (l *Level) UnmarshalText(text []byte) error {
+	if l == nil {
+		return New("nil receiver")
+	}
+	lv, err := ParseLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*l = lv
+	return nil
+}
+
+// ParseLevel function returns severity level from its name (case insensitive, "warning" and "fatal" are accepted too).
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	case "critical", "fatal":
+		return LevelCritical, nil
+	}
+	return LevelUnknown, New("unknown severity level", WithContext("name", name))
+}
+
+// WithSeverity function returns ErrorContextFunc function value.
+// This function is used in New and Wrap functions that represents severity level of error.
+// The level is stored as "severity" context value.
+func WithSeverity(level Level) ErrorContextFunc {
+	return WithContext("severity", level)
+}
+
+// Severity function returns the highest severity level in error tree (see WithSeverity function),
+// including all children of *Errors and other multi-errors.
+// Severity names restored by DecodeJSON function are accepted too.
+// If error tree has no severity, it returns DefaultSeverity.
+// It returns LevelUnknown if err is nil.
+func Severity(err error) Level {
+	if err == nil {
+		return LevelUnknown
+	}
+	if lv := maxSeverity(err); lv != LevelUnknown {
+		return lv
+	}
+	return DefaultSeverity
+}
+
+func maxSeverity(err error) Level {
+	if err == nil {
+		return LevelUnknown
+	}
+	lv := LevelUnknown
+	if e, ok := err.(*Error); ok && e != nil {
+		lv = levelOf(e.Context["severity"])
+	}
+	for _, c := range Children(err) {
+		if l := maxSeverity(c); l > lv {
+			lv = l
+		}
+	}
+	return lv
+}
+
+// levelOf returns severity level from context value.
+func levelOf(v interface{}) Level {
+	switch l := v.(type) {
+	case Level:
+		if _, ok := levelNames[l]; ok {
+			return l
+		}
+	case string:
+		if lv, err := ParseLevel(l); err == nil {
+			return lv
+		}
+	}
+	return LevelUnknown
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
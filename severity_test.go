@@ -0,0 +1,109 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestSeverity(t *testing.T) {
+	testCases := []struct {
+		err  error
+		want Level
+	}{
+		{err: nil, want: LevelUnknown},
+		{err: os.ErrInvalid, want: DefaultSeverity},
+		{err: New("message"), want: DefaultSeverity},
+		{err: New("message", WithSeverity(LevelInfo)), want: LevelInfo},
+		{err: Wrap(New("message", WithSeverity(LevelCritical)), WithSeverity(LevelWarn)), want: LevelCritical},
+		{err: New("message", WithSeverity(LevelDebug), WithCause(New("cause", WithSeverity(LevelWarn)))), want: LevelWarn},
+		{err: Join(New("1", WithSeverity(LevelInfo)), io.EOF, New("2", WithSeverity(LevelCritical))), want: LevelCritical},
+		{err: errors.Join(New("1", WithSeverity(LevelInfo)), Wrap(io.EOF, WithSeverity(LevelDebug))), want: LevelInfo},
+		{err: New("message", WithContext("severity", "warning")), want: LevelWarn},
+		{err: New("message", WithContext("severity", "unknown")), want: DefaultSeverity},
+	}
+
+	for _, tc := range testCases {
+		if got := Severity(tc.err); got != tc.want {
+			t.Errorf("Severity(%v) is %v, want %v", tc.err, got, tc.want)
+		}
+		res, err := DecodeJSON([]byte(EncodeJSON(tc.err)))
+		if err != nil {
+			t.Errorf("DecodeJSON(%v) is \"%v\", want <nil>", EncodeJSON(tc.err), err)
+			continue
+		}
+		if got := Severity(res); got != tc.want {
+			t.Errorf("Severity(DecodeJSON(%v)) is %v, want %v", EncodeJSON(tc.err), got, tc.want)
+		}
+		data, err := EncodeGob(tc.err)
+		if err != nil {
+			t.Errorf("EncodeGob(%v) is \"%v\", want <nil>", tc.err, err)
+			continue
+		}
+		if res, err = DecodeGob(data); err != nil {
+			t.Errorf("DecodeGob(%v) is \"%v\", want <nil>", tc.err, err)
+			continue
+		}
+		if got := Severity(res); got != tc.want {
+			t.Errorf("Severity(DecodeGob(%v)) is %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestSeverityJSON(t *testing.T) {
+	err := New("message", WithSeverity(LevelWarn))
+	want := `{"Type":"*errs.Error","Err":{"Type":"*errors.errorString","Msg":"message"},"Context":{"function":"github.com/goark/errs.TestSeverityJSON","severity":"warn"}}`
+	if got := EncodeJSON(err); got != want {
+		t.Errorf("EncodeJSON(%v) is %v, want %v", err, got, want)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	testCases := []struct {
+		name string
+		want Level
+		err  bool
+	}{
+		{name: "debug", want: LevelDebug},
+		{name: "INFO", want: LevelInfo},
+		{name: "warn", want: LevelWarn},
+		{name: "warning", want: LevelWarn},
+		{name: "error", want: LevelError},
+		{name: "critical", want: LevelCritical},
+		{name: "fatal", want: LevelCritical},
+		{name: "foo", want: LevelUnknown, err: true},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseLevel(tc.name)
+		if got != tc.want {
+			t.Errorf("ParseLevel(%v) is %v, want %v", tc.name, got, tc.want)
+		}
+		if (err != nil) != tc.err {
+			t.Errorf("ParseLevel(%v) error is \"%v\", want error %v", tc.name, err, tc.err)
+		}
+		if err != nil {
+			continue
+		}
+		var lv Level
+		if e := lv.UnmarshalText([]byte(tc.name)); e != nil || lv != tc.want {
+			t.Errorf("Level.UnmarshalText(%v) is %v (%v), want %v", tc.name, lv, e, tc.want)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,11 @@
+module github.com/goark/errs/slogobject
+
+go 1.21
+
+require github.com/goark/errs v1.4.0
+
+require golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da // indirect
+
+// The errs module is developed in the same repository (see ../go.work).
+// Remove this directive after github.com/goark/errs v1.4.0 is released.
+replace github.com/goark/errs => ../
@@ -0,0 +1,137 @@
+// Package slogobject implements log/slog adapter for errs package.
+package slogobject
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+
+	"github.com/goark/errs"
+)
+
+// LevelCritical is slog level for errs.LevelCritical severity.
+const LevelCritical = slog.LevelError + 4
+
+// ErrValue is a wrapper of error instance for log/slog.
+// Causes of multi-error are output as group with index keys ("0", "1", ...).
+type ErrValue struct {
+	Err  error
+	Mode errs.OutputMode
+}
+
+var _ slog.LogValuer = ErrValue{} //ErrValue type is compatible with slog.LogValuer interface
+
+// New wrapped error instance in ErrValue.
+func New(err error) ErrValue {
+	return ErrValue{Err: err}
+}
+
+// NewWithMode wrapped error instance in ErrValue with output mode.
+// In errs.OutputPublic mode, ErrValue outputs public message only (see errs.PublicMessage function).
+func NewWithMode(err error, mode errs.OutputMode) ErrValue {
+	return ErrValue{Err: err, Mode: mode}
+}
+
+// LogValue method returns group value of error tree.
+// This method is implementation of slog.LogValuer interface.
+func (e ErrValue) LogValue() slog.Value {
+	if e.Err == nil {
+		return slog.GroupValue()
+	}
+	if e.Mode == errs.OutputPublic {
+		return slog.GroupValue(slog.String("msg", errs.PublicMessage(e.Err)))
+	}
+	if ee, ok := e.Err.(*errs.Error); ok {
+		attrs := []slog.Attr{
+			slog.String("type", fmt.Sprintf("%T", ee)),
+			slog.String("msg", ee.Error()),
+		}
+		if ee.Err != nil {
+			attrs = append(attrs, slog.Any("error", New(ee.Err)))
+		}
+		if ee.Cause != nil {
+			attrs = append(attrs, slog.Any("cause", New(ee.Cause)))
+		}
+		if len(ee.Context) > 0 {
+			keys := make([]string, 0, len(ee.Context))
+			for k := range ee.Context {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			ctx := make([]slog.Attr, 0, len(keys))
+			for _, k := range keys {
+				ctx = append(ctx, slog.Any(k, ee.Context[k]))
+			}
+			attrs = append(attrs, slog.Attr{Key: "context", Value: slog.GroupValue(ctx...)})
+		}
+		return slog.GroupValue(attrs...)
+	}
+	attrs := []slog.Attr{
+		slog.String("type", errs.TypeName(e.Err)),
+		slog.String("msg", e.Err.Error()),
+	}
+	if es, ok := e.Err.(*errs.Errors); ok {
+		if es.Dropped() > 0 {
+			attrs = append(attrs, slog.Int("dropped", es.Dropped()))
+		}
+		if counts := es.OccurrenceCounts(); len(counts) > 0 {
+			attrs = append(attrs, slog.Any("counts", counts))
+		}
+	}
+	if fields := errs.ExtraFields(e.Err); len(fields) > 0 {
+		attrs = append(attrs, slog.Any("fields", fields))
+	}
+	if errList := errs.Unwraps(e.Err); len(errList) > 0 {
+		if len(errList) == 1 {
+			attrs = append(attrs, slog.Any("cause", New(errList[0])))
+		} else {
+			causes := make([]slog.Attr, 0, len(errList))
+			for i, c := range errList {
+				causes = append(causes, slog.Any(strconv.Itoa(i), New(c)))
+			}
+			attrs = append(attrs, slog.Attr{Key: "causes", Value: slog.GroupValue(causes...)})
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// Level function returns slog level from severity of error tree (see errs.Severity function).
+// errs.LevelCritical is mapped to LevelCritical.
+func Level(err error) slog.Level {
+	switch errs.Severity(err) {
+	case errs.LevelDebug:
+		return slog.LevelDebug
+	case errs.LevelInfo:
+		return slog.LevelInfo
+	case errs.LevelWarn:
+		return slog.LevelWarn
+	case errs.LevelCritical:
+		return LevelCritical
+	}
+	return slog.LevelError
+}
+
+// Log function writes error instance as "error" attribute at the log level of Level function.
+func Log(ctx context.Context, logger *slog.Logger, msg string, err error, args ...interface{}) {
+	if logger == nil {
+		return
+	}
+	logger.Log(ctx, Level(err), msg, append(args, slog.Any("error", New(err)))...)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
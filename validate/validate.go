@@ -0,0 +1,270 @@
+// Package validate implements field-level validation errors with JSON Pointer locations for errs package.
+package validate
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+// typeNameFieldError is type name of FieldError (also restored as *errs.RemoteError).
+const typeNameFieldError = "*validate.FieldError"
+
+func init() {
+	errs.RegisterEncoder(func(e *FieldError) map[string]interface{} {
+		fields := map[string]interface{}{
+			"Pointer":    e.Pointer(),
+			"Path":       e.Dotted(),
+			"Constraint": e.Constraint,
+			"Message":    e.Message,
+		}
+		if len(e.Params) > 0 {
+			fields["Params"] = e.Params
+		}
+		return fields
+	})
+}
+
+// FieldError is a validation error of a field.
+type FieldError struct {
+	Path       []string               // path segments from the root (object keys and array indexes)
+	Constraint string                 // name of constraint (e.g. "required", "min")
+	Params     map[string]interface{} // parameters of constraint (e.g. "min": 1)
+	Message    string                 // message of the violation (e.g. "must be positive")
+	Err        error                  // cause error (optional)
+}
+
+var _ error = (*FieldError)(nil) //FieldError type is compatible with error interface
+
+// Pointer method returns path of the field as JSON Pointer (RFC 6901), e.g. "/items/3/price".
+func (e *FieldError) Pointer() string {
+	if e == nil || len(e.Path) == 0 {
+		return ""
+	}
+	elms := make([]string, 0, len(e.Path))
+	for _, seg := range e.Path {
+		elms = append(elms, strings.ReplaceAll(strings.ReplaceAll(seg, "~", "~0"), "/", "~1"))
+	}
+	return "/" + strings.Join(elms, "/")
+}
+
+// Dotted method returns path of the field in dotted form, e.g. "items.3.price".
+func (e *FieldError) Dotted() string {
+	if e == nil {
+		return ""
+	}
+	return strings.Join(e.Path, ".")
+}
+
+// Error method returns error message ("items.3.price must be positive").
+// This method is a implementation of error interface.
+func (e *FieldError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	msg := e.message()
+	if len(e.Path) == 0 {
+		return msg
+	}
+	return strings.Join([]string{e.Dotted(), msg}, " ")
+}
+
+// Unwrap method returns cause error in FieldError instance.
+// This method is used in errors.Unwrap function.
+func (e *FieldError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// message returns message of the violation (made from constraint or cause error if Message is empty).
+func (e *FieldError) message() string {
+	switch {
+	case len(e.Message) > 0:
+		return e.Message
+	case e.Err != nil:
+		return e.Err.Error()
+	case len(e.Constraint) > 0:
+		return "violates " + e.Constraint
+	}
+	return "is invalid"
+}
+
+// Builder is a builder of validation errors. Builder for nested field shares the errors with the parent.
+// Builder is safe for concurrent use (by *errs.Errors).
+type Builder struct {
+	path []string
+	errs *errs.Errors
+}
+
+// New function returns Builder instance for the root.
+// The options are passed to errs.NewErrors function (capacity limit and so on).
+func New(opts ...errs.ErrorsOption) *Builder {
+	return &Builder{errs: errs.NewErrors(opts...)}
+}
+
+// Field method returns Builder instance for the field of object.
+func (b *Builder) Field(name string) *Builder {
+	return b.child(name)
+}
+
+// Index method returns Builder instance for the element of array.
+func (b *Builder) Index(i int) *Builder {
+	return b.child(strconv.Itoa(i))
+}
+
+func (b *Builder) child(seg string) *Builder {
+	path := make([]string, len(b.path), len(b.path)+1)
+	copy(path, b.path)
+	return &Builder{path: append(path, seg), errs: b.errs}
+}
+
+// Add method adds FieldError instance of the field to the errors.
+func (b *Builder) Add(constraint, message string, params map[string]interface{}) *Builder {
+	b.errs.Add(&FieldError{Path: b.Path(), Constraint: constraint, Params: params, Message: message})
+	return b
+}
+
+// Check method adds FieldError instance of the field if ok is false.
+func (b *Builder) Check(ok bool, constraint, message string, params map[string]interface{}) *Builder {
+	if !ok {
+		return b.Add(constraint, message, params)
+	}
+	return b
+}
+
+// AddError method adds err as the cause of FieldError instance of the field.
+// If err is nil, nothing is added.
+func (b *Builder) AddError(constraint string, err error) *Builder {
+	if err != nil {
+		b.errs.Add(&FieldError{Path: b.Path(), Constraint: constraint, Err: err})
+	}
+	return b
+}
+
+// Path method returns path segments of the field.
+func (b *Builder) Path() []string {
+	path := make([]string, len(b.path))
+	copy(path, b.path)
+	return path
+}
+
+// Errors method returns *errs.Errors instance shared by the builders.
+func (b *Builder) Errors() *errs.Errors {
+	return b.errs
+}
+
+// Err method returns *errs.Errors instance if any error is added, or nil otherwise.
+func (b *Builder) Err() error {
+	return b.errs.ErrorOrNil()
+}
+
+// InvalidParam is an element of "invalid-params" member in problem details (RFC 7807).
+type InvalidParam struct {
+	Name       string                 `json:"name"`
+	Pointer    string                 `json:"pointer,omitempty"`
+	Reason     string                 `json:"reason"`
+	Constraint string                 `json:"constraint,omitempty"`
+	Params     map[string]interface{} `json:"params,omitempty"`
+}
+
+// InvalidParams function returns "invalid-params" member of problem details (RFC 7807) from FieldError instances in error tree.
+// FieldError instances restored as *errs.RemoteError (errs.DecodeJSON function etc.) are recognized too.
+func InvalidParams(err error) []InvalidParam {
+	var params []InvalidParam
+	for _, fe := range FieldErrors(err) {
+		params = append(params, InvalidParam{
+			Name:       fe.Dotted(),
+			Pointer:    fe.Pointer(),
+			Reason:     fe.message(),
+			Constraint: fe.Constraint,
+			Params:     fe.Params,
+		})
+	}
+	return params
+}
+
+// Map function returns messages by path (dotted form) of FieldError instances in error tree.
+// FieldError instances restored as *errs.RemoteError are recognized too.
+func Map(err error) map[string][]string {
+	fes := FieldErrors(err)
+	if len(fes) == 0 {
+		return nil
+	}
+	m := make(map[string][]string, len(fes))
+	for _, fe := range fes {
+		m[fe.Dotted()] = append(m[fe.Dotted()], fe.message())
+	}
+	return m
+}
+
+// FieldErrors function returns FieldError instances in error tree (depth-first order).
+// FieldError instances restored as *errs.RemoteError are converted into FieldError.
+func FieldErrors(err error) []*FieldError {
+	var fes []*FieldError
+	errs.Walk(err, func(e error, _ int) bool {
+		if fe := toFieldError(e); fe != nil {
+			fes = append(fes, fe)
+			return false
+		}
+		return true
+	})
+	return fes
+}
+
+// toFieldError returns FieldError instance from *FieldError or *errs.RemoteError.
+func toFieldError(err error) *FieldError {
+	switch e := err.(type) {
+	case *FieldError:
+		return e
+	case *errs.RemoteError:
+		if e.Type != typeNameFieldError {
+			return nil
+		}
+		fe := &FieldError{Path: parsePointer(stringField(e.Fields, "Pointer"))}
+		fe.Constraint = stringField(e.Fields, "Constraint")
+		fe.Message = stringField(e.Fields, "Message")
+		if params, ok := e.Fields["Params"].(map[string]interface{}); ok {
+			fe.Params = params
+		}
+		if causes := e.Unwrap(); len(causes) == 1 {
+			fe.Err = causes[0]
+		}
+		return fe
+	}
+	return nil
+}
+
+func stringField(fields map[string]interface{}, key string) string {
+	s, _ := fields[key].(string)
+	return s
+}
+
+// parsePointer returns path segments from JSON Pointer.
+func parsePointer(ptr string) []string {
+	if len(ptr) == 0 {
+		return nil
+	}
+	segs := strings.Split(strings.TrimPrefix(ptr, "/"), "/")
+	for i, seg := range segs {
+		segs[i] = strings.ReplaceAll(strings.ReplaceAll(seg, "~1", "/"), "~0", "~")
+	}
+	return segs
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
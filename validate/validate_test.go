@@ -0,0 +1,115 @@
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/goark/errs"
+)
+
+func TestFieldError(t *testing.T) {
+	testCases := []struct {
+		err     *FieldError
+		pointer string
+		dotted  string
+		msg     string
+	}{
+		{err: &FieldError{Path: []string{"items", "3", "price"}, Constraint: "positive", Message: "must be positive"}, pointer: "/items/3/price", dotted: "items.3.price", msg: "items.3.price must be positive"},
+		{err: &FieldError{Path: []string{"a/b", "c~d"}, Constraint: "required"}, pointer: "/a~1b/c~0d", dotted: "a/b.c~d", msg: "a/b.c~d violates required"},
+		{err: &FieldError{Path: []string{"name"}, Err: io.EOF}, pointer: "/name", dotted: "name", msg: "name EOF"},
+		{err: &FieldError{}, pointer: "", dotted: "", msg: "is invalid"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.err.Pointer(); got != tc.pointer {
+			t.Errorf("FieldError.Pointer() is %q, want %q", got, tc.pointer)
+		}
+		if got := tc.err.Dotted(); got != tc.dotted {
+			t.Errorf("FieldError.Dotted() is %q, want %q", got, tc.dotted)
+		}
+		if got := tc.err.Error(); got != tc.msg {
+			t.Errorf("FieldError.Error() is %q, want %q", got, tc.msg)
+		}
+		if got := parsePointer(tc.pointer); len(tc.err.Path) > 0 && !reflect.DeepEqual(got, tc.err.Path) {
+			t.Errorf("parsePointer(%q) is %v, want %v", tc.pointer, got, tc.err.Path)
+		}
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	b := New()
+	if err := b.Err(); err != nil {
+		t.Errorf("Builder.Err() is %v, want <nil>", err)
+	}
+	items := b.Field("items")
+	items.Index(3).Field("price").Check(false, "positive", "must be positive", nil)
+	items.Index(4).Field("price").Check(true, "positive", "must be positive", nil)
+	items.Index(5).Field("qty").Add("min", "must be at least 1", map[string]interface{}{"min": 1})
+	b.Field("name").AddError("required", io.EOF).AddError("required", nil)
+	b.Field("name").Add("length", "is too long", nil)
+
+	err := b.Err()
+	var es *errs.Errors
+	if !errors.As(err, &es) || len(es.Unwrap()) != 4 {
+		t.Errorf("Builder.Err() is %#v, want *errs.Errors with 4 errors", err)
+	}
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("errors.Is(%v, io.EOF) is false, want true", err)
+	}
+	wantMap := map[string][]string{
+		"items.3.price": {"must be positive"},
+		"items.5.qty":   {"must be at least 1"},
+		"name":          {"EOF", "is too long"},
+	}
+	if got := Map(err); !reflect.DeepEqual(got, wantMap) {
+		t.Errorf("Map() is %v, want %v", got, wantMap)
+	}
+	wantParams := `[{"name":"items.3.price","pointer":"/items/3/price","reason":"must be positive","constraint":"positive"},{"name":"items.5.qty","pointer":"/items/5/qty","reason":"must be at least 1","constraint":"min","params":{"min":1}},{"name":"name","pointer":"/name","reason":"EOF","constraint":"required"},{"name":"name","pointer":"/name","reason":"is too long","constraint":"length"}]`
+	if b, e := json.Marshal(InvalidParams(err)); e != nil || string(b) != wantParams {
+		t.Errorf("InvalidParams() is %s, want %s", b, wantParams)
+	}
+
+	// JSON round-trip
+	data := errs.EncodeJSON(errs.Wrap(err))
+	if !strings.Contains(data, `"Type":"*validate.FieldError","Msg":"items.3.price must be positive","Fields":{"Constraint":"positive","Message":"must be positive","Path":"items.3.price","Pointer":"/items/3/price"}`) {
+		t.Errorf("errs.EncodeJSON() is %v, want FieldError with fields", data)
+	}
+	res, e := errs.DecodeJSON([]byte(data))
+	if e != nil {
+		t.Errorf("errs.DecodeJSON() is \"%v\", want <nil>", e)
+		return
+	}
+	if got := Map(res); !reflect.DeepEqual(got, wantMap) {
+		t.Errorf("Map(DecodeJSON()) is %v, want %v", got, wantMap)
+	}
+}
+
+func TestMapNil(t *testing.T) {
+	for _, err := range []error{nil, io.EOF, errs.Wrap(io.EOF)} {
+		if got := Map(err); got != nil {
+			t.Errorf("Map(%v) is %v, want <nil>", err, got)
+		}
+		if got := InvalidParams(err); got != nil {
+			t.Errorf("InvalidParams(%v) is %v, want <nil>", err, got)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,83 @@
+package errs
+
+import "fmt"
+
+// TypeName function returns type name of error instance (original type name for *RemoteError).
+func TypeName(err error) string {
+	if e, ok := err.(*RemoteError); ok && e != nil {
+		return e.Type
+	}
+	return fmt.Sprintf("%T", err)
+}
+
+// Children function returns child errors of error instance in error tree:
+// Err and Cause fields for *Error, and the result of Unwraps function for other errors.
+// nil children are left out.
+func Children(err error) []error {
+	var list []error
+	if e, ok := err.(*Error); ok {
+		if e == nil {
+			return nil
+		}
+		list = []error{e.Err, e.Cause}
+	} else {
+		list = Unwraps(err)
+	}
+	children := make([]error, 0, len(list))
+	for _, c := range list {
+		if c != nil {
+			children = append(children, c)
+		}
+	}
+	return children
+}
+
+// Walk function visits all errors in error tree (see Children function) with depth-first order.
+// depth is 0 for err itself. Children of the error are not visited if fn returns false.
+func Walk(err error, fn func(err error, depth int) bool) {
+	walk(err, 0, fn)
+}
+
+func walk(err error, depth int, fn func(error, int) bool) {
+	if err == nil || !fn(err, depth) {
+		return
+	}
+	for _, c := range Children(err) {
+		walk(c, depth+1, fn)
+	}
+}
+
+// FindOutermost function returns the outermost error in error tree (breadth-first order, see Children function)
+// that satisfies fn. It returns nil if no error satisfies fn.
+func FindOutermost(err error, fn func(error) bool) error {
+	if err == nil {
+		return nil
+	}
+	level := []error{err}
+	for len(level) > 0 {
+		next := []error{}
+		for _, e := range level {
+			if fn(e) {
+				return e
+			}
+			next = append(next, Children(e)...)
+		}
+		level = next
+	}
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
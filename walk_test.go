@@ -0,0 +1,79 @@
+package errs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTypeName(t *testing.T) {
+	testCases := []struct {
+		err  error
+		want string
+	}{
+		{err: nil, want: "<nil>"},
+		{err: io.EOF, want: "*errors.errorString"},
+		{err: New("foo"), want: "*errs.Error"},
+		{err: &RemoteError{Type: "*fs.PathError"}, want: "*fs.PathError"},
+	}
+
+	for _, tc := range testCases {
+		if got := TypeName(tc.err); got != tc.want {
+			t.Errorf("TypeName(%v) is %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestWalk(t *testing.T) {
+	err := Wrap(io.EOF, WithCause(Join(os.ErrNotExist, errors.Join(io.ErrUnexpectedEOF))))
+	var visited []string
+	Walk(err, func(e error, depth int) bool {
+		visited = append(visited, strings.Repeat(" ", depth)+TypeName(e))
+		return depth < 2
+	})
+	want := "*errs.Error\n *errors.errorString\n *errs.Errors\n  *errors.errorString\n  *errors.joinError"
+	if got := strings.Join(visited, "\n"); got != want {
+		t.Errorf("Walk() visits\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestFindOutermost(t *testing.T) {
+	inner := New("inner", WithContext("code", 2))
+	outer := New("outer", WithContext("code", 1), WithCause(inner))
+	hasCode := func(e error) bool {
+		ee, ok := e.(*Error)
+		return ok && ee.Context["code"] != nil
+	}
+	testCases := []struct {
+		err  error
+		want error
+	}{
+		{err: nil, want: nil},
+		{err: io.EOF, want: nil},
+		{err: outer, want: outer},
+		{err: Join(io.EOF, Wrap(outer), inner), want: inner},
+	}
+
+	for _, tc := range testCases {
+		if got := FindOutermost(tc.err, hasCode); got != tc.want {
+			t.Errorf("FindOutermost(%v) is %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
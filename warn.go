@@ -0,0 +1,57 @@
+package errs
+
+import "context"
+
+// warningsKey is the key of *Errors for warnings in context.Context.
+type warningsKey struct{}
+
+// WithCollector function returns a copy of ctx that carries new *Errors instance for warnings (see Warn function).
+// The options are passed to NewErrors function (capacity limit, dedup mode and so on).
+// If ctx already carries *Errors instance, the new one shadows it.
+func WithCollector(ctx context.Context, opts ...ErrorsOption) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, warningsKey{}, NewErrors(opts...))
+}
+
+// Warn function adds a non-fatal error to *Errors instance carried by ctx (see WithCollector function).
+// err is wrapped by *Error instance with caller context and options, in the same way as Wrap function
+// (use WithCtx option to add context data extracted from ctx).
+// It reports whether the warning is recorded (false if err is nil or ctx carries no *Errors instance).
+func Warn(ctx context.Context, err error, opts ...ErrorContextFunc) bool {
+	if err == nil {
+		return false
+	}
+	es := Warnings(ctx)
+	if es == nil {
+		return false
+	}
+	es.Add(newError(err, true, 2, opts...))
+	return true
+}
+
+// Warnings function returns *Errors instance of warnings carried by ctx (see WithCollector function).
+// It returns nil if ctx carries no *Errors instance.
+func Warnings(ctx context.Context) *Errors {
+	if ctx == nil {
+		return nil
+	}
+	es, _ := ctx.Value(warningsKey{}).(*Errors)
+	return es
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
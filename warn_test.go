@@ -0,0 +1,93 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestWarn(t *testing.T) {
+	ctx := WithCollector(context.Background())
+	if Warn(ctx, nil) {
+		t.Error("Warn(ctx, nil) is true, want false")
+	}
+	if !Warn(ctx, io.EOF, WithContext("foo", "bar")) {
+		t.Error("Warn(ctx, io.EOF) is false, want true")
+	}
+	es := Warnings(ctx)
+	list := es.Unwrap()
+	if len(list) != 1 {
+		t.Errorf("Warnings() is %v, want 1 warning", es)
+		return
+	}
+	e, ok := list[0].(*Error)
+	if !ok {
+		t.Errorf("warning is %T, want *errs.Error", list[0])
+		return
+	}
+	if got, want := e.Context["function"], "github.com/goark/errs.TestWarn"; got != want {
+		t.Errorf("\"function\" context of warning is %v, want %v", got, want)
+	}
+	if got := e.Context["foo"]; got != "bar" {
+		t.Errorf("\"foo\" context of warning is %v, want %v", got, "bar")
+	}
+	if !errors.Is(es, io.EOF) {
+		t.Errorf("errors.Is(%v, io.EOF) is false, want true", es)
+	}
+}
+
+func TestWarnNoCollector(t *testing.T) {
+	if Warn(context.Background(), io.EOF) {
+		t.Error("Warn() without collector is true, want false")
+	}
+	if got := Warnings(context.Background()); got != nil {
+		t.Errorf("Warnings() without collector is %v, want <nil>", got)
+	}
+}
+
+func TestWarnConcurrent(t *testing.T) {
+	ctx := WithCollector(context.Background(), WithDedup(DedupByMessage))
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Warn(ctx, os.ErrNotExist)
+		}()
+	}
+	wg.Wait()
+	occurs := Warnings(ctx).Occurrences()
+	if len(occurs) != 1 || occurs[0].Count != 100 {
+		t.Errorf("Warnings().Occurrences() is %v, want 1 warning with 100 times", occurs)
+	}
+}
+
+func TestWarnShadow(t *testing.T) {
+	outer := WithCollector(context.Background())
+	inner := WithCollector(outer)
+	Warn(inner, io.EOF)
+	if Warnings(outer).ErrorOrNil() != nil {
+		t.Errorf("outer Warnings() is %v, want empty", Warnings(outer))
+	}
+	if Warnings(inner).ErrorOrNil() == nil {
+		t.Error("inner Warnings() is empty, want a warning")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
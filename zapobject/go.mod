@@ -3,11 +3,16 @@ module github.com/goark/errs/zapobject
 go 1.20
 
 require (
-	github.com/goark/errs v1.3.0
+	github.com/goark/errs v1.4.0
 	go.uber.org/zap v1.24.0
 )
 
 require (
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
+	golang.org/x/xerrors v0.0.0-20240903120638-7835f813f4da // indirect
 )
+
+// The errs module is developed in the same repository (see ../go.work).
+// Remove this directive after github.com/goark/errs v1.4.0 is released.
+replace github.com/goark/errs => ../
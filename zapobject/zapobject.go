@@ -10,7 +10,8 @@ import (
 )
 
 type ErrObject struct {
-	Err error
+	Err  error
+	Mode errs.OutputMode
 }
 
 // New wrapped error instance in ErrObject.
@@ -18,11 +19,21 @@ func New(err error) ErrObject {
 	return ErrObject{Err: err}
 }
 
+// NewWithMode wrapped error instance in ErrObject with output mode.
+// In errs.OutputPublic mode, ErrObject outputs public message only (see errs.PublicMessage function).
+func NewWithMode(err error, mode errs.OutputMode) ErrObject {
+	return ErrObject{Err: err, Mode: mode}
+}
+
 // MarshalLogObject method is object marshaler for go.uber.org/zap.
 func (e ErrObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if e.Err == nil {
 		return nil
 	}
+	if e.Mode == errs.OutputPublic {
+		enc.AddString("msg", errs.PublicMessage(e.Err))
+		return nil
+	}
 	var ee *errs.Error
 	if errs.As(e.Err, &ee) {
 		enc.AddString("type", fmt.Sprintf("%T", ee))
@@ -49,8 +60,21 @@ func (e ErrObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 			}
 		}
 	} else {
-		enc.AddString("type", fmt.Sprintf("%T", e.Err))
+		enc.AddString("type", errs.TypeName(e.Err))
 		enc.AddString("msg", e.Err.Error())
+		if es, ok := e.Err.(*errs.Errors); ok {
+			if es.Dropped() > 0 {
+				enc.AddInt("dropped", es.Dropped())
+			}
+			if counts := es.OccurrenceCounts(); len(counts) > 0 {
+				zap.Ints("counts", counts).AddTo(enc)
+			}
+		}
+		if fields := errs.ExtraFields(e.Err); len(fields) > 0 {
+			if err := enc.AddReflected("fields", fields); err != nil {
+				return err
+			}
+		}
 		if errList := errs.Unwraps(e.Err); len(errList) > 0 {
 			if len(errList) == 1 {
 				return enc.AddObject("cause", New(errList[0]))
@@ -65,6 +89,30 @@ func (e ErrObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	return nil
 }
 
+// Level function returns zap log level from severity of error tree (see errs.Severity function).
+// errs.LevelCritical is mapped to zapcore.ErrorLevel (severity is output in context of error anyway).
+func Level(err error) zapcore.Level {
+	switch errs.Severity(err) {
+	case errs.LevelDebug:
+		return zapcore.DebugLevel
+	case errs.LevelInfo:
+		return zapcore.InfoLevel
+	case errs.LevelWarn:
+		return zapcore.WarnLevel
+	}
+	return zapcore.ErrorLevel
+}
+
+// Log function writes error instance as "error" field at the log level of Level function.
+func Log(logger *zap.Logger, msg string, err error, fields ...zap.Field) {
+	if logger == nil {
+		return
+	}
+	if ce := logger.Check(Level(err), msg); ce != nil {
+		ce.Write(append(fields, zap.Object("error", New(err)))...)
+	}
+}
+
 /* Copyright 2023 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");